@@ -0,0 +1,168 @@
+package gui
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/color/palette"
+	"image/draw"
+	"image/gif"
+	"image/png"
+	"io"
+	"os"
+	"time"
+)
+
+// FrameWriter writes frames, such as those presented by a Window
+// with the CaptureFrames option.
+//
+// The image passed to WriteFrame is only valid during the call, and t is
+// the time when the frame was presented.
+type FrameWriter interface {
+	WriteFrame(img image.Image, t time.Time) error
+}
+
+// GIFWriter is a FrameWriter that encodes the frames as an animated GIF,
+// the delay of each frame is the time until the next frame.
+//
+// GIF delays are in 100ths of a second, so frames less than 10ms apart
+// are merged, keeping the latest image. The frames are kept in memory
+// until the GIF is written by Close.
+type GIFWriter struct {
+	w   io.Writer
+	gif *gif.GIF
+	err error
+
+	// offsets are the times of the frames since the
+	// first frame, in 100ths of a second.
+	start   time.Time
+	offsets []int
+}
+
+// NewGIFWriter creates a new GIFWriter that writes the animated GIF to w.
+func NewGIFWriter(w io.Writer) *GIFWriter {
+	return &GIFWriter{w: w, gif: &gif.GIF{}}
+}
+
+// WriteFrame adds the image as a frame of the GIF, using the Plan 9 palette.
+func (gw *GIFWriter) WriteFrame(img image.Image, t time.Time) error {
+	if gw.err != nil {
+		return gw.err
+	}
+
+	p := image.NewPaletted(img.Bounds(), palette.Plan9)
+
+	draw.FloydSteinberg.Draw(p, p.Rect, img, img.Bounds().Min)
+
+	if len(gw.offsets) == 0 {
+		gw.start = t
+	}
+
+	offset := centiseconds(t.Sub(gw.start))
+
+	if n := len(gw.offsets); n > 0 && offset <= gw.offsets[n-1] {
+		gw.gif.Image[n-1] = p
+		return nil
+	}
+
+	gw.gif.Image = append(gw.gif.Image, p)
+	gw.offsets = append(gw.offsets, offset)
+
+	return nil
+}
+
+// Close writes the animated GIF, returning the first error that occurred.
+func (gw *GIFWriter) Close() error {
+	if gw.err != nil {
+		return gw.err
+	}
+
+	if len(gw.gif.Image) == 0 {
+		gw.err = fmt.Errorf("gui: no frames to write as GIF")
+		return gw.err
+	}
+
+	// The frames may have different sizes if the window was resized
+	var r image.Rectangle
+
+	for _, p := range gw.gif.Image {
+		r = r.Union(p.Rect)
+	}
+
+	gw.gif.Config = image.Config{
+		ColorModel: color.Palette(palette.Plan9),
+		Width:      r.Max.X,
+		Height:     r.Max.Y,
+	}
+	gw.gif.Delay = gifDelays(gw.offsets)
+	gw.err = gif.EncodeAll(gw.w, gw.gif)
+
+	return gw.err
+}
+
+// gifDelays returns the delays between the increasing offsets, which keeps
+// the total duration of the animation, since the rounding of each offset
+// does not accumulate. The last frame is shown as long as the one before it.
+func gifDelays(offsets []int) []int {
+	delays := make([]int, len(offsets))
+
+	for i := 0; i < len(offsets)-1; i++ {
+		delays[i] = offsets[i+1] - offsets[i]
+	}
+
+	if n := len(delays); n > 1 {
+		delays[n-1] = delays[n-2]
+	}
+
+	return delays
+}
+
+// centiseconds returns d rounded to 100ths of a second.
+func centiseconds(d time.Duration) int {
+	return int(d.Round(10*time.Millisecond) / (10 * time.Millisecond))
+}
+
+// PNGWriter is a FrameWriter that writes each frame to a numbered PNG file.
+type PNGWriter struct {
+	pattern string
+	n       int
+	err     error
+}
+
+// NewPNGWriter creates a new PNGWriter that writes the frames to files named
+// by formatting pattern with the frame number, such as "frame-%04d.png".
+func NewPNGWriter(pattern string) *PNGWriter {
+	return &PNGWriter{pattern: pattern}
+}
+
+// WriteFrame writes the image to the next file in the sequence.
+func (pw *PNGWriter) WriteFrame(img image.Image, t time.Time) error {
+	if pw.err != nil {
+		return pw.err
+	}
+
+	pw.err = WritePNG(fmt.Sprintf(pw.pattern, pw.n), img)
+	pw.n++
+
+	return pw.err
+}
+
+// Close returns the first error that occurred when writing the frames.
+func (pw *PNGWriter) Close() error {
+	return pw.err
+}
+
+// WritePNG encodes img as PNG to a file with the given name.
+func WritePNG(name string, img image.Image) error {
+	f, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+
+	if err := png.Encode(f, img); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
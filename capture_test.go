@@ -0,0 +1,162 @@
+package gui
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/png"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestGIFWriter(t *testing.T) {
+	var buf bytes.Buffer
+
+	gw := NewGIFWriter(&buf)
+
+	start := time.Now()
+
+	for i, c := range []color.RGBA{
+		{255, 0, 0, 255},
+		{0, 255, 0, 255},
+		{0, 0, 255, 255},
+	} {
+		img := image.NewRGBA(image.Rect(0, 0, 4, 4+i))
+		img.Set(0, 0, c)
+
+		if err := gw.WriteFrame(img, start.Add(time.Duration(i)*100*time.Millisecond)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if err := gw.Close(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	g, err := gif.DecodeAll(&buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got, want := len(g.Image), 3; got != want {
+		t.Fatalf("len(g.Image) = %d, want %d", got, want)
+	}
+
+	if got, want := g.Delay, []int{10, 10, 10}; !equalInts(got, want) {
+		t.Fatalf("g.Delay = %v, want %v", got, want)
+	}
+
+	if got, want := g.Config.Height, 6; got != want {
+		t.Fatalf("g.Config.Height = %d, want %d", got, want)
+	}
+
+	if err := NewGIFWriter(&buf).Close(); err == nil {
+		t.Fatalf("expected error when closing GIFWriter without frames")
+	}
+}
+
+func TestPNGWriter(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gui-png-writer")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	pw := NewPNGWriter(filepath.Join(dir, "frame-%02d.png"))
+
+	for i := 0; i < 2; i++ {
+		if err := pw.WriteFrame(image.NewRGBA(image.Rect(0, 0, 2, 2)), time.Now()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if err := pw.Close(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	f, err := os.Open(filepath.Join(dir, "frame-01.png"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer f.Close()
+
+	img, err := png.Decode(f)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got, want := img.Bounds(), image.Rect(0, 0, 2, 2); got != want {
+		t.Fatalf("img.Bounds() = %v, want %v", got, want)
+	}
+}
+
+func TestGIFDelays(t *testing.T) {
+	if got, want := gifDelays([]int{0, 4, 10}), []int{4, 6, 6}; !equalInts(got, want) {
+		t.Fatalf("gifDelays() = %v, want %v", got, want)
+	}
+}
+
+func TestGIFWriterTiming(t *testing.T) {
+	gw := NewGIFWriter(ioutil.Discard)
+
+	start := time.Now()
+
+	// Frames every 15ms, with an extra frame 4ms after the first
+	for _, ms := range []int{0, 4, 15, 30, 45, 60} {
+		img := image.NewRGBA(image.Rect(0, 0, 1, 1))
+
+		if err := gw.WriteFrame(img, start.Add(time.Duration(ms)*time.Millisecond)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if got, want := gw.offsets, []int{0, 2, 3, 5, 6}; !equalInts(got, want) {
+		t.Fatalf("gw.offsets = %v, want %v", got, want)
+	}
+
+	delays := gifDelays(gw.offsets)
+
+	var total int
+
+	for _, d := range delays[:len(delays)-1] {
+		total += d
+	}
+
+	if got, want := total, 6; got != want {
+		t.Fatalf("total delay = %d, want %d", got, want)
+	}
+}
+
+func equalInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// recordingFrameWriter records copies of the frames, or returns err.
+type recordingFrameWriter struct {
+	frames []image.Image
+	err    error
+}
+
+func (fw *recordingFrameWriter) WriteFrame(img image.Image, t time.Time) error {
+	if fw.err != nil {
+		return fw.err
+	}
+
+	fw.frames = append(fw.frames, copyRGBA(img.(*image.RGBA)))
+
+	return nil
+}
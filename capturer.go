@@ -0,0 +1,180 @@
+package gui
+
+import (
+	"image"
+	"image/draw"
+	"sync"
+	"time"
+)
+
+// Capturer is an Env that wraps another Env and writes frames to a
+// FrameWriter, such as a GIFWriter or a PNGWriter. Like a Window, the
+// damage of draw calls is batched into frames, and a frame is only
+// written if the image was damaged.
+//
+// The events channel of the Capturer is closed after the events channel of
+// the wrapped Env, once all frames have been written.
+type Capturer struct {
+	env    Env
+	events <-chan Event
+	frames chan capturedFrame
+	done   chan struct{}
+
+	// mu guards the fields below and sending frames
+	mu         sync.Mutex
+	shadow     *image.RGBA
+	damaged    bool
+	closed     bool
+	timer      *time.Timer
+	frameDelay time.Duration
+	lastFrame  time.Time
+
+	errMu sync.Mutex
+	err   error
+}
+
+type capturedFrame struct {
+	img *image.RGBA
+	t   time.Time
+}
+
+// NewCapturer creates a new Capturer that writes the frames drawn to env to
+// fw, at most rate frames per second. A rate of zero defaults to 50 frames
+// per second, which is the highest rate commonly played back in GIFs.
+// The event queue of the Capturer is configured like the queue of env.
+func NewCapturer(env Env, fw FrameWriter, rate int) *Capturer {
+	out, in := makeCountedEventsChan(eventQueueOf(env), nil, nil)
+
+	if rate <= 0 {
+		rate = 50
+	}
+
+	c := &Capturer{
+		env:        env,
+		events:     out,
+		frames:     make(chan capturedFrame, 16),
+		done:       make(chan struct{}),
+		frameDelay: frameDelay(rate),
+		lastFrame:  time.Now(),
+	}
+
+	// The timer is only running while a frame is pending
+	c.timer = time.AfterFunc(time.Hour, c.frame)
+	c.timer.Stop()
+
+	go c.write(fw)
+
+	go func() {
+		for e := range env.Events() {
+			in <- e
+		}
+
+		c.mu.Lock()
+		c.timer.Stop()
+
+		// Queue the last frame, that would otherwise never be written
+		if c.damaged {
+			c.queueFrame(time.Now())
+		}
+
+		c.closed = true
+		close(c.frames)
+		c.mu.Unlock()
+
+		<-c.done
+
+		close(in)
+	}()
+
+	return c
+}
+
+// Err returns the first error that occurred when writing the frames.
+func (c *Capturer) Err() error {
+	c.errMu.Lock()
+	defer c.errMu.Unlock()
+
+	return c.err
+}
+
+// Events returns the events channel of the wrapped Env.
+func (c *Capturer) Events() <-chan Event { return c.events }
+
+func (c *Capturer) eventQueue() QueueConfig { return eventQueueOf(c.env) }
+
+// Draw to the wrapped Env using the provided function.
+func (c *Capturer) Draw(fn func(draw.Image) image.Rectangle) {
+	c.env.Draw(func(dst draw.Image) image.Rectangle {
+		r := fn(dst)
+
+		c.capture(dst, r)
+
+		return r
+	})
+}
+
+// Close closes the wrapped Env.
+func (c *Capturer) Close() {
+	c.env.Close()
+}
+
+// capture copies the damaged rectangle r of dst to the shadow
+// image, and starts the timer for the next frame if needed.
+func (c *Capturer) capture(dst draw.Image, r image.Rectangle) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return
+	}
+
+	bounds := dst.Bounds()
+
+	if c.shadow == nil || c.shadow.Bounds() != bounds {
+		c.shadow = image.NewRGBA(bounds)
+
+		draw.Draw(c.shadow, bounds, dst, bounds.Min, draw.Src)
+	} else {
+		draw.Draw(c.shadow, r, dst, r.Min, draw.Src)
+	}
+
+	if r.Intersect(bounds).Empty() || c.damaged {
+		return
+	}
+
+	c.damaged = true
+	c.timer.Reset(time.Until(c.lastFrame.Add(c.frameDelay)))
+}
+
+// frame is called by the timer when the next frame is due.
+func (c *Capturer) frame() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed || !c.damaged {
+		return
+	}
+
+	c.queueFrame(time.Now())
+}
+
+// queueFrame queues a copy of the shadow image as a frame, mu must be held.
+func (c *Capturer) queueFrame(t time.Time) {
+	c.frames <- capturedFrame{copyRGBA(c.shadow), t}
+	c.damaged = false
+	c.lastFrame = t
+}
+
+func (c *Capturer) write(fw FrameWriter) {
+	defer close(c.done)
+
+	for f := range c.frames {
+		if err := fw.WriteFrame(f.img, f.t); err != nil {
+			c.errMu.Lock()
+			if c.err == nil {
+				c.err = err
+			}
+			c.errMu.Unlock()
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package gui
+
+import (
+	"errors"
+	"image"
+	"image/color"
+	"image/draw"
+	"testing"
+	"time"
+)
+
+func TestCapturerIsEnv(t *testing.T) {
+	var _ Env = &Capturer{}
+}
+
+func TestCapturer(t *testing.T) {
+	fw := &recordingFrameWriter{}
+	c := NewCapturer(NewHeadless(Size(4, 4)), fw, 1)
+
+	<-c.Events()
+
+	red := color.RGBA{255, 0, 0, 255}
+
+	c.Draw(func(dst draw.Image) image.Rectangle {
+		dst.Set(1, 1, red)
+
+		return image.Rect(1, 1, 2, 2)
+	})
+
+	c.Draw(func(dst draw.Image) image.Rectangle {
+		return image.ZR
+	})
+
+	c.Draw(func(dst draw.Image) image.Rectangle {
+		dst.Set(2, 2, red)
+
+		return image.Rect(2, 2, 3, 3)
+	})
+
+	c.Close()
+
+	for range c.Events() {
+	}
+
+	if err := c.Err(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got, want := len(fw.frames), 1; got != want {
+		t.Fatalf("len(fw.frames) = %d, want %d", got, want)
+	}
+
+	if got, want := fw.frames[0].At(1, 1), red; got != want {
+		t.Fatalf("fw.frames[0].At(1, 1) = %v, want %v", got, want)
+	}
+
+	if got, want := fw.frames[0].At(2, 2), red; got != want {
+		t.Fatalf("fw.frames[0].At(2, 2) = %v, want %v", got, want)
+	}
+}
+
+func TestCapturerFrameRate(t *testing.T) {
+	fw := &recordingFrameWriter{}
+	c := NewCapturer(NewHeadless(Size(4, 4)), fw, 1000)
+
+	<-c.Events()
+
+	red := color.RGBA{255, 0, 0, 255}
+
+	c.Draw(func(dst draw.Image) image.Rectangle {
+		dst.Set(1, 1, red)
+
+		return image.Rect(1, 1, 2, 2)
+	})
+
+	time.Sleep(50 * time.Millisecond)
+
+	c.Draw(func(dst draw.Image) image.Rectangle {
+		dst.Set(2, 2, red)
+
+		return image.Rect(2, 2, 3, 3)
+	})
+
+	c.Close()
+
+	for range c.Events() {
+	}
+
+	if got, want := len(fw.frames), 2; got != want {
+		t.Fatalf("len(fw.frames) = %d, want %d", got, want)
+	}
+
+	if got, want := fw.frames[0].At(2, 2), (color.RGBA{}); got != want {
+		t.Fatalf("fw.frames[0].At(2, 2) = %v, want %v", got, want)
+	}
+
+	if got, want := fw.frames[1].At(1, 1), red; got != want {
+		t.Fatalf("fw.frames[1].At(1, 1) = %v, want %v", got, want)
+	}
+}
+
+func TestCapturerErr(t *testing.T) {
+	fw := &recordingFrameWriter{err: errors.New("test-error")}
+	c := NewCapturer(NewHeadless(), fw, 0)
+
+	c.Draw(func(dst draw.Image) image.Rectangle {
+		return dst.Bounds()
+	})
+
+	c.Close()
+
+	for range c.Events() {
+	}
+
+	if got, want := c.Err(), fw.err; got != want {
+		t.Fatalf("c.Err() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,140 @@
+package gui
+
+import (
+	"errors"
+	"fmt"
+	"image"
+
+	"github.com/go-gl/glfw/v3.3/glfw"
+)
+
+// errClosed is returned by the methods of a Window
+// that has been closed, instead of using its GLFW window.
+var errClosed = errors.New("gui: window is closed")
+
+// SetTitle sets the title (caption) of the window.
+func (w *Window) SetTitle(title string) {
+	w.call(func() {
+		w.w.SetTitle(title)
+	})
+}
+
+// SetSize sets the width and height of the window in pixels.
+//
+// The window is resized asynchronously, followed by an EventResize.
+func (w *Window) SetSize(width, height int) {
+	w.call(func() {
+		w.w.SetSize(screenSize(width, w.pixelScaleX), screenSize(height, w.pixelScaleY))
+	})
+}
+
+// Scale returns the content scale of the window, which is the ratio
+// between the current DPI and the platform default DPI.
+func (w *Window) Scale() float64 {
+	var scale float32
+
+	w.call(func() {
+		scale, _ = w.w.GetContentScale()
+	})
+
+	return float64(scale)
+}
+
+// SetPosition moves the window to the given position in screen coordinates.
+func (w *Window) SetPosition(x, y int) {
+	w.call(func() {
+		w.w.SetPos(x, y)
+	})
+}
+
+// Fullscreen makes the window fullscreen on the monitor with the given
+// index, where the monitor with index 0 is the primary monitor.
+func (w *Window) Fullscreen(monitor int) error {
+	return w.FullscreenMode(monitor, VideoMode{})
+}
+
+// FullscreenMode makes the window fullscreen on the monitor with the given
+// index, using the video mode closest to mode. Zero fields in mode default
+// to the current video mode of the monitor.
+func (w *Window) FullscreenMode(monitor int, mode VideoMode) error {
+	var err error
+
+	call(func() {
+		if w.destroyed {
+			err = errClosed
+			return
+		}
+
+		monitors := glfw.GetMonitors()
+
+		if monitor < 0 || monitor >= len(monitors) {
+			err = fmt.Errorf("gui: no monitor with index %d", monitor)
+			return
+		}
+
+		m := monitors[monitor]
+		current := makeVideoMode(m.GetVideoMode())
+
+		if mode.Width == 0 || mode.Height == 0 {
+			mode.Width, mode.Height = current.Width, current.Height
+		}
+
+		if mode.RefreshRate == 0 {
+			mode.RefreshRate = current.RefreshRate
+		}
+
+		if mode.Width == 0 || mode.Height == 0 {
+			err = fmt.Errorf("gui: no video mode for monitor with index %d", monitor)
+			return
+		}
+
+		if w.w.GetMonitor() == nil {
+			x, y := w.w.GetPos()
+			width, height := w.w.GetSize()
+
+			w.windowed = image.Rect(x, y, x+width, y+height)
+		}
+
+		w.w.SetMonitor(m, 0, 0, mode.Width, mode.Height, mode.RefreshRate)
+	})
+
+	return err
+}
+
+// Windowed makes a fullscreen window return to its previous position and size.
+func (w *Window) Windowed() {
+	w.call(func() {
+		if w.w.GetMonitor() == nil {
+			return
+		}
+
+		r := w.windowed
+
+		w.w.SetMonitor(nil, r.Min.X, r.Min.Y, r.Dx(), r.Dy(), 0)
+	})
+}
+
+// Maximize the window.
+func (w *Window) Maximize() {
+	w.call(w.w.Maximize)
+}
+
+// Minimize the window.
+func (w *Window) Minimize() {
+	w.call(w.w.Iconify)
+}
+
+// Restore the window from being minimized or maximized.
+func (w *Window) Restore() {
+	w.call(w.w.Restore)
+}
+
+// Show the window, if it is hidden.
+func (w *Window) Show() {
+	w.call(w.w.Show)
+}
+
+// Hide the window, if it is shown.
+func (w *Window) Hide() {
+	w.call(w.w.Hide)
+}
@@ -0,0 +1,93 @@
+package gui
+
+import (
+	"image"
+
+	"github.com/go-gl/glfw/v3.3/glfw"
+)
+
+// CursorShape is one of the standard shapes of the mouse cursor.
+type CursorShape int
+
+// The standard cursor shapes
+const (
+	CursorArrow CursorShape = iota
+	CursorIBeam
+	CursorCrosshair
+	CursorHand
+	CursorHResize
+	CursorVResize
+)
+
+var standardCursors = map[CursorShape]glfw.StandardCursor{
+	CursorArrow:     glfw.ArrowCursor,
+	CursorIBeam:     glfw.IBeamCursor,
+	CursorCrosshair: glfw.CrosshairCursor,
+	CursorHand:      glfw.HandCursor,
+	CursorHResize:   glfw.HResizeCursor,
+	CursorVResize:   glfw.VResizeCursor,
+}
+
+// CursorMode controls the visibility and movement of the mouse cursor.
+type CursorMode int
+
+// The cursor modes
+const (
+	// CursorNormal is a visible cursor that moves freely.
+	CursorNormal CursorMode = iota
+
+	// CursorHidden is hidden when over the window, but moves freely.
+	CursorHidden
+
+	// CursorDisabled is hidden and captured by the window, mouse movement
+	// is sent as EventMouseRelative instead of EventMouseMove.
+	CursorDisabled
+)
+
+var cursorModes = map[CursorMode]int{
+	CursorNormal:   glfw.CursorNormal,
+	CursorHidden:   glfw.CursorHidden,
+	CursorDisabled: glfw.CursorDisabled,
+}
+
+// SetCursorShape sets the cursor of the window to one of the standard shapes.
+func (w *Window) SetCursorShape(shape CursorShape) {
+	w.call(func() {
+		w.setCursor(glfw.CreateStandardCursor(standardCursors[shape]))
+	})
+}
+
+// SetCursorImage sets the cursor of the window to the image,
+// the hotspot is the point in the image that is the position of the cursor.
+func (w *Window) SetCursorImage(img image.Image, hotspot image.Point) {
+	hotspot = hotspot.Sub(img.Bounds().Min)
+
+	w.call(func() {
+		w.setCursor(glfw.CreateCursor(img, hotspot.X, hotspot.Y))
+	})
+}
+
+// SetCursorMode sets the mode of the cursor of the window.
+func (w *Window) SetCursorMode(mode CursorMode) {
+	w.call(func() {
+		w.w.SetInputMode(glfw.CursorMode, cursorModes[mode])
+
+		if glfw.RawMouseMotionSupported() {
+			w.w.SetInputMode(glfw.RawMouseMotion, glfwBool(mode == CursorDisabled))
+		}
+
+		w.cursorMode = mode
+		w.cursorX, w.cursorY = w.w.GetCursorPos()
+	})
+}
+
+// setCursor must be called on the main thread.
+func (w *Window) setCursor(c *glfw.Cursor) {
+	w.w.SetCursor(c)
+
+	if w.cursor != nil {
+		w.cursor.Destroy()
+	}
+
+	w.cursor = c
+}
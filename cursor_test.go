@@ -0,0 +1,19 @@
+package gui
+
+import "testing"
+
+func TestStandardCursors(t *testing.T) {
+	for shape := CursorArrow; shape <= CursorVResize; shape++ {
+		if _, ok := standardCursors[shape]; !ok {
+			t.Fatalf("missing standard cursor for shape %d", shape)
+		}
+	}
+}
+
+func TestCursorModes(t *testing.T) {
+	for mode := CursorNormal; mode <= CursorDisabled; mode++ {
+		if _, ok := cursorModes[mode]; !ok {
+			t.Fatalf("missing cursor mode %d", mode)
+		}
+	}
+}
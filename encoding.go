@@ -0,0 +1,172 @@
+package gui
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"image"
+	"reflect"
+)
+
+// MarshalEvent returns the JSON encoding of the event, including its name.
+func MarshalEvent(e Event) ([]byte, error) {
+	return json.Marshal(JSONEvent{e})
+}
+
+// UnmarshalEvent parses the JSON encoding of an event,
+// returning it as a value of its concrete type.
+func UnmarshalEvent(data []byte) (Event, error) {
+	var je JSONEvent
+
+	if err := json.Unmarshal(data, &je); err != nil {
+		return nil, err
+	}
+
+	return je.Event, nil
+}
+
+// JSONEvent wraps an Event in order to encode it as JSON,
+// it can be used as a field in other types that are encoded as JSON.
+type JSONEvent struct {
+	Event
+}
+
+type jsonEvent struct {
+	Name string          `json:"name"`
+	Data json.RawMessage `json:"data,omitempty"`
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (je JSONEvent) MarshalJSON() ([]byte, error) {
+	if je.Event == nil {
+		return []byte("null"), nil
+	}
+
+	data, err := json.Marshal(je.Event)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(jsonEvent{Name: je.Name(), Data: data})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (je *JSONEvent) UnmarshalJSON(data []byte) error {
+	var v jsonEvent
+
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	e, err := decodeEvent(v.Name, v.Data)
+	if err != nil {
+		return err
+	}
+
+	je.Event = e
+
+	return nil
+}
+
+// binaryPointEvents are high-rate events with compact binary encodings,
+// their tag in the binary encoding is their index in the slice plus one.
+var binaryPointEvents = []struct {
+	name string
+	fn   func(image.Point) Event
+}{
+	{"mouse/move", func(p image.Point) Event { return EventMouseMove{p} }},
+	{"mouse/scroll", func(p image.Point) Event { return EventMouseScroll{p} }},
+	{"mouse/relative", func(p image.Point) Event { return EventMouseRelative{p} }},
+}
+
+// MarshalEventBinary returns the binary encoding of the event.
+//
+// Events such as EventMouseMove are encoded as a tag byte followed by the
+// coordinates as varints, other events are encoded as a zero byte followed
+// by the length of the name, the name and the JSON encoding of the event.
+func MarshalEventBinary(e Event) ([]byte, error) {
+	name := e.Name()
+
+	for i, bpe := range binaryPointEvents {
+		if p, ok := e.Data().(image.Point); ok && bpe.name == name {
+			buf := make([]byte, 1+2*binary.MaxVarintLen64)
+
+			buf[0] = byte(i + 1)
+			n := 1
+			n += binary.PutVarint(buf[n:], int64(p.X))
+			n += binary.PutVarint(buf[n:], int64(p.Y))
+
+			return buf[:n], nil
+		}
+	}
+
+	data, err := json.Marshal(e)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := make([]byte, 1+binary.MaxVarintLen64, 1+binary.MaxVarintLen64+len(name)+len(data))
+
+	buf = buf[:1+binary.PutUvarint(buf[1:], uint64(len(name)))]
+	buf = append(buf, name...)
+	buf = append(buf, data...)
+
+	return buf, nil
+}
+
+// UnmarshalEventBinary parses the binary encoding of an event,
+// returning it as a value of its concrete type.
+func UnmarshalEventBinary(data []byte) (Event, error) {
+	if len(data) == 0 {
+		return nil, errBinaryEvent
+	}
+
+	tag, data := int(data[0]), data[1:]
+
+	if tag > len(binaryPointEvents) {
+		return nil, errBinaryEvent
+	}
+
+	if tag > 0 {
+		x, n := binary.Varint(data)
+		if n <= 0 {
+			return nil, errBinaryEvent
+		}
+
+		y, m := binary.Varint(data[n:])
+		if m <= 0 || n+m != len(data) {
+			return nil, errBinaryEvent
+		}
+
+		return binaryPointEvents[tag-1].fn(image.Pt(int(x), int(y))), nil
+	}
+
+	l, n := binary.Uvarint(data)
+	if n <= 0 || l > uint64(len(data)-n) {
+		return nil, errBinaryEvent
+	}
+
+	name, data := string(data[n:n+int(l)]), data[n+int(l):]
+
+	return decodeEvent(name, data)
+}
+
+var errBinaryEvent = errors.New("gui: invalid binary encoding of event")
+
+func decodeEvent(name string, data []byte) (Event, error) {
+	zero, ok := LookupEvent(name)
+	if !ok {
+		return nil, fmt.Errorf("gui: unknown event %q", name)
+	}
+
+	v := reflect.New(reflect.TypeOf(zero))
+
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, v.Interface()); err != nil {
+			return nil, err
+		}
+	}
+
+	return v.Elem().Interface().(Event), nil
+}
@@ -0,0 +1,144 @@
+package gui
+
+import (
+	"encoding/json"
+	"image"
+	"reflect"
+	"testing"
+	"time"
+)
+
+var testEvents = []Event{
+	EventUpdate{time.Date(2019, 5, 18, 12, 0, 0, 0, time.UTC)},
+	EventResize{image.Rect(0, 1, 2, 3)},
+	EventClose{},
+	EventFocusGained{},
+	EventFocusLost{},
+	EventMouseEnter{},
+	EventMouseLeave{},
+	EventMinimize{},
+	EventMaximize{},
+	EventRestore{},
+	EventMove{image.Pt(-10, 20)},
+	EventFrame{time.Second / 60, time.Millisecond},
+	EventScale{1.25},
+	EventMonitorConnected{"DP-1"},
+	EventMonitorDisconnected{"DP-1"},
+	EventMouseMove{image.Pt(1, -2)},
+	EventMouseRelative{image.Pt(-3, 4)},
+	EventMouseScroll{image.Pt(0, -1)},
+	EventMouseLeftDown{Point: image.Pt(1, 2), Mods: ModShift},
+	EventMouseLeftUp{Point: image.Pt(1, 2)},
+	EventMouseMiddleDown{Point: image.Pt(1, 2)},
+	EventMouseMiddleUp{Point: image.Pt(1, 2)},
+	EventMouseRightDown{Point: image.Pt(1, 2)},
+	EventMouseRightUp{Point: image.Pt(1, 2)},
+	EventDrop{image.Pt(1, 2), []string{"a.png", "b.png"}},
+	EventKeyboardChar{'x'},
+	EventKeyboardDown{Key: "s", Mods: ModCtrl},
+	EventKeyboardUp{Key: "escape"},
+	EventKeyboardRepeat{Key: "escape"},
+}
+
+func TestMarshalEvent(t *testing.T) {
+	data, err := MarshalEvent(EventMouseMove{image.Pt(1, 2)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got, want := string(data), `{"name":"mouse/move","data":{"X":1,"Y":2}}`; got != want {
+		t.Fatalf("MarshalEvent = %s, want %s", got, want)
+	}
+}
+
+func TestUnmarshalEvent(t *testing.T) {
+	for _, e := range testEvents {
+		data, err := MarshalEvent(e)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		got, err := UnmarshalEvent(data)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !reflect.DeepEqual(got, e) {
+			t.Fatalf("UnmarshalEvent(%s) = %#v, want %#v", data, got, e)
+		}
+	}
+
+	for _, data := range []string{
+		`{"name":"unknown"}`,
+		`{"name":"mouse/move","data":"1,2"}`,
+		`[]`,
+	} {
+		if _, err := UnmarshalEvent([]byte(data)); err == nil {
+			t.Fatalf("expected error for %s", data)
+		}
+	}
+}
+
+func TestJSONEvent(t *testing.T) {
+	type message struct {
+		Event JSONEvent `json:"event"`
+	}
+
+	data, err := json.Marshal(message{JSONEvent{EventKeyboardChar{'x'}}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var m message
+
+	if err := json.Unmarshal(data, &m); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got, want := m.Event.Event, (EventKeyboardChar{'x'}); got != want {
+		t.Fatalf("m.Event.Event = %#v, want %#v", got, want)
+	}
+}
+
+func TestMarshalEventBinary(t *testing.T) {
+	data, err := MarshalEventBinary(EventMouseMove{image.Pt(1, -2)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got, want := len(data), 3; got != want {
+		t.Fatalf("len(data) = %d, want %d", got, want)
+	}
+}
+
+func TestUnmarshalEventBinary(t *testing.T) {
+	for _, e := range testEvents {
+		data, err := MarshalEventBinary(e)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		got, err := UnmarshalEventBinary(data)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !reflect.DeepEqual(got, e) {
+			t.Fatalf("UnmarshalEventBinary(%v) = %#v, want %#v", data, got, e)
+		}
+	}
+
+	for _, data := range [][]byte{
+		nil,
+		{1},
+		{1, 2},
+		{1, 2, 4, 6},
+		{255},
+		{0, 10, 'c', 'l', 'o', 's', 'e'},
+		{0, 7, 'u', 'n', 'k', 'n', 'o', 'w', 'n'},
+	} {
+		if _, err := UnmarshalEventBinary(data); err == nil {
+			t.Fatalf("expected error for %v", data)
+		}
+	}
+}
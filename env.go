@@ -11,3 +11,15 @@ type Env interface {
 	Draw(func(draw.Image) image.Rectangle)
 	Close()
 }
+
+// Clipboarder is implemented by an Env with access to a text clipboard.
+type Clipboarder interface {
+	ClipboardText() string
+	SetClipboardText(text string)
+}
+
+// ImageClipboarder is implemented by an Env with access to an image clipboard.
+type ImageClipboarder interface {
+	ClipboardImage() image.Image
+	SetClipboardImage(img image.Image)
+}
@@ -10,6 +10,10 @@ func TestWindowIsEnv(t *testing.T) {
 	var _ Env = &Window{}
 }
 
+func TestWindowIsClipboarder(t *testing.T) {
+	var _ Clipboarder = &Window{}
+}
+
 type mockEnv struct {
 	EventsFn func() <-chan Event
 	DrawFn   func(func(draw.Image) image.Rectangle)
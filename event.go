@@ -26,6 +26,25 @@ func (t EventUpdate) Data() interface{} {
 	return t.Time
 }
 
+// EventFrame event
+type EventFrame struct {
+	// Delta is the time since the previous frame
+	Delta time.Duration
+
+	// Present is the time it took to present the frame
+	Present time.Duration
+}
+
+// Name of event
+func (f EventFrame) Name() string {
+	return "frame"
+}
+
+// Data for event
+func (f EventFrame) Data() interface{} {
+	return f.Delta
+}
+
 // EventResize event
 type EventResize struct {
 	image.Rectangle
@@ -54,6 +73,159 @@ func (c EventClose) Data() interface{} {
 	return nil
 }
 
+// EventFocusGained event
+type EventFocusGained struct{}
+
+// Name of event
+func (fg EventFocusGained) Name() string {
+	return "focus/gained"
+}
+
+// Data for event
+func (fg EventFocusGained) Data() interface{} {
+	return nil
+}
+
+// EventFocusLost event
+type EventFocusLost struct{}
+
+// Name of event
+func (fl EventFocusLost) Name() string {
+	return "focus/lost"
+}
+
+// Data for event
+func (fl EventFocusLost) Data() interface{} {
+	return nil
+}
+
+// EventMouseEnter event
+type EventMouseEnter struct{}
+
+// Name of event
+func (me EventMouseEnter) Name() string {
+	return "mouse/enter"
+}
+
+// Data for event
+func (me EventMouseEnter) Data() interface{} {
+	return nil
+}
+
+// EventMouseLeave event
+type EventMouseLeave struct{}
+
+// Name of event
+func (ml EventMouseLeave) Name() string {
+	return "mouse/leave"
+}
+
+// Data for event
+func (ml EventMouseLeave) Data() interface{} {
+	return nil
+}
+
+// EventMinimize event
+type EventMinimize struct{}
+
+// Name of event
+func (mi EventMinimize) Name() string {
+	return "window/minimize"
+}
+
+// Data for event
+func (mi EventMinimize) Data() interface{} {
+	return nil
+}
+
+// EventMaximize event
+type EventMaximize struct{}
+
+// Name of event
+func (ma EventMaximize) Name() string {
+	return "window/maximize"
+}
+
+// Data for event
+func (ma EventMaximize) Data() interface{} {
+	return nil
+}
+
+// EventRestore event
+type EventRestore struct{}
+
+// Name of event
+func (re EventRestore) Name() string {
+	return "window/restore"
+}
+
+// Data for event
+func (re EventRestore) Data() interface{} {
+	return nil
+}
+
+// EventMove event
+//
+// The position of the window is in screen coordinates.
+type EventMove struct {
+	image.Point
+}
+
+// Name of event
+func (mo EventMove) Name() string {
+	return "window/move"
+}
+
+// Data for event
+func (mo EventMove) Data() interface{} {
+	return mo.Point
+}
+
+// EventScale event
+type EventScale struct {
+	Scale float64
+}
+
+// Name of event
+func (sc EventScale) Name() string {
+	return "window/scale"
+}
+
+// Data for event
+func (sc EventScale) Data() interface{} {
+	return sc.Scale
+}
+
+// EventMonitorConnected event
+type EventMonitorConnected struct {
+	Monitor string
+}
+
+// Name of event
+func (mc EventMonitorConnected) Name() string {
+	return "monitor/connected"
+}
+
+// Data for event
+func (mc EventMonitorConnected) Data() interface{} {
+	return mc.Monitor
+}
+
+// EventMonitorDisconnected event
+type EventMonitorDisconnected struct {
+	Monitor string
+}
+
+// Name of event
+func (md EventMonitorDisconnected) Name() string {
+	return "monitor/disconnected"
+}
+
+// Data for event
+func (md EventMonitorDisconnected) Data() interface{} {
+	return md.Monitor
+}
+
 // EventMouseMove event
 type EventMouseMove struct {
 	image.Point
@@ -69,6 +241,24 @@ func (mm EventMouseMove) Data() interface{} {
 	return mm.Point
 }
 
+// EventMouseRelative event
+//
+// The point is the movement of the mouse since the previous event,
+// sent instead of EventMouseMove when the cursor is disabled.
+type EventMouseRelative struct {
+	image.Point
+}
+
+// Name of event
+func (mr EventMouseRelative) Name() string {
+	return "mouse/relative"
+}
+
+// Data for event
+func (mr EventMouseRelative) Data() interface{} {
+	return mr.Point
+}
+
 // EventMouseScroll event
 type EventMouseScroll struct {
 	image.Point
@@ -87,6 +277,7 @@ func (ms EventMouseScroll) Data() interface{} {
 // EventMouseLeftDown event
 type EventMouseLeftDown struct {
 	image.Point
+	Mods Modifiers
 }
 
 // Name of event
@@ -102,6 +293,7 @@ func (mld EventMouseLeftDown) Data() interface{} {
 // EventMouseLeftUp event
 type EventMouseLeftUp struct {
 	image.Point
+	Mods Modifiers
 }
 
 // Name of event
@@ -117,6 +309,7 @@ func (mlu EventMouseLeftUp) Data() interface{} {
 // EventMouseMiddleDown event
 type EventMouseMiddleDown struct {
 	image.Point
+	Mods Modifiers
 }
 
 // Name of event
@@ -132,6 +325,7 @@ func (mmd EventMouseMiddleDown) Data() interface{} {
 // EventMouseMiddleUp event
 type EventMouseMiddleUp struct {
 	image.Point
+	Mods Modifiers
 }
 
 // Name of event
@@ -147,6 +341,7 @@ func (mmu EventMouseMiddleUp) Data() interface{} {
 // EventMouseRightDown event
 type EventMouseRightDown struct {
 	image.Point
+	Mods Modifiers
 }
 
 // Name of event
@@ -162,6 +357,7 @@ func (mrd EventMouseRightDown) Data() interface{} {
 // EventMouseRightUp event
 type EventMouseRightUp struct {
 	image.Point
+	Mods Modifiers
 }
 
 // Name of event
@@ -174,6 +370,24 @@ func (mru EventMouseRightUp) Data() interface{} {
 	return mru.Point
 }
 
+// EventDrop event
+//
+// Paths are the files dropped onto the window, at the position of the mouse.
+type EventDrop struct {
+	image.Point
+	Paths []string
+}
+
+// Name of event
+func (d EventDrop) Name() string {
+	return "drop"
+}
+
+// Data for event
+func (d EventDrop) Data() interface{} {
+	return d.Paths
+}
+
 // EventKeyboardChar event
 type EventKeyboardChar struct {
 	Char rune
@@ -190,8 +404,13 @@ func (kc EventKeyboardChar) Data() interface{} {
 }
 
 // EventKeyboardDown event
+//
+// Key is empty for keys without a name, such as media keys,
+// those can still be told apart by their platform specific Scancode.
 type EventKeyboardDown struct {
-	Key string
+	Key      string
+	Mods     Modifiers
+	Scancode int
 }
 
 // Name of event
@@ -205,8 +424,13 @@ func (kd EventKeyboardDown) Data() interface{} {
 }
 
 // EventKeyboardUp event
+//
+// Key is empty for keys without a name, such as media keys,
+// those can still be told apart by their platform specific Scancode.
 type EventKeyboardUp struct {
-	Key string
+	Key      string
+	Mods     Modifiers
+	Scancode int
 }
 
 // Name of event
@@ -220,8 +444,13 @@ func (ku EventKeyboardUp) Data() interface{} {
 }
 
 // EventKeyboardRepeat event
+//
+// Key is empty for keys without a name, such as media keys,
+// those can still be told apart by their platform specific Scancode.
 type EventKeyboardRepeat struct {
-	Key string
+	Key      string
+	Mods     Modifiers
+	Scancode int
 }
 
 // Name of event
@@ -235,36 +464,5 @@ func (kr EventKeyboardRepeat) Data() interface{} {
 }
 
 func makeEventsChan() (<-chan Event, chan<- Event) {
-	out, in := make(chan Event), make(chan Event)
-
-	go func() {
-		var queue []Event
-
-		for {
-			x, ok := <-in
-			if !ok {
-				close(out)
-				return
-			}
-			queue = append(queue, x)
-
-			for len(queue) > 0 {
-				select {
-				case out <- queue[0]:
-					queue = queue[1:]
-				case x, ok := <-in:
-					if !ok {
-						for _, x := range queue {
-							out <- x
-						}
-						close(out)
-						return
-					}
-					queue = append(queue, x)
-				}
-			}
-		}
-	}()
-
-	return out, in
+	return makeCountedEventsChan(QueueConfig{}, nil, nil)
 }
@@ -2,7 +2,10 @@ package gui
 
 import (
 	"image"
+	"reflect"
+	"sync/atomic"
 	"testing"
+	"time"
 )
 
 func TestMakeEventsChan(t *testing.T) {
@@ -19,6 +22,36 @@ func TestMakeEventsChan(t *testing.T) {
 	}
 }
 
+func TestMakeCountedEventsChan(t *testing.T) {
+	var depth int64
+
+	out, in := makeCountedEventsChan(QueueConfig{}, &depth, nil)
+
+	for i := 0; i < 3; i++ {
+		in <- EventClose{}
+	}
+
+	// The last event may still be on its way into the queue
+	deadline := time.Now().Add(time.Second)
+
+	for atomic.LoadInt64(&depth) < 3 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+
+	if got, want := atomic.LoadInt64(&depth), int64(3); got != want {
+		t.Fatalf("depth = %d, want %d", got, want)
+	}
+
+	close(in)
+
+	for range out {
+	}
+
+	if got := atomic.LoadInt64(&depth); got != 0 {
+		t.Fatalf("depth = %d, want 0", got)
+	}
+}
+
 func TestEventNames(t *testing.T) {
 	for _, tt := range []struct {
 		event Event
@@ -26,7 +59,20 @@ func TestEventNames(t *testing.T) {
 	}{
 		{EventResize{}, "resize"},
 		{EventClose{}, "close"},
+		{EventFocusGained{}, "focus/gained"},
+		{EventFocusLost{}, "focus/lost"},
+		{EventMouseEnter{}, "mouse/enter"},
+		{EventMouseLeave{}, "mouse/leave"},
+		{EventMinimize{}, "window/minimize"},
+		{EventMaximize{}, "window/maximize"},
+		{EventRestore{}, "window/restore"},
+		{EventMove{}, "window/move"},
+		{EventFrame{}, "frame"},
+		{EventScale{}, "window/scale"},
+		{EventMonitorConnected{}, "monitor/connected"},
+		{EventMonitorDisconnected{}, "monitor/disconnected"},
 		{EventMouseMove{}, "mouse/move"},
+		{EventMouseRelative{}, "mouse/relative"},
 		{EventMouseScroll{}, "mouse/scroll"},
 		{EventMouseLeftDown{}, "mouse/left/down"},
 		{EventMouseLeftUp{}, "mouse/left/up"},
@@ -34,6 +80,7 @@ func TestEventNames(t *testing.T) {
 		{EventMouseMiddleUp{}, "mouse/middle/up"},
 		{EventMouseRightDown{}, "mouse/right/down"},
 		{EventMouseRightUp{}, "mouse/right/up"},
+		{EventDrop{}, "drop"},
 		{EventKeyboardChar{}, "keyboard/char"},
 		{EventKeyboardDown{}, "keyboard/down"},
 		{EventKeyboardUp{}, "keyboard/up"},
@@ -62,6 +109,102 @@ func TestEventData(t *testing.T) {
 		}
 	})
 
+	t.Run("EventFocusGained", func(t *testing.T) {
+		e := EventFocusGained{}
+
+		if got := e.Data(); got != nil {
+			t.Fatalf("e.Data() = %v", got)
+		}
+	})
+
+	t.Run("EventFocusLost", func(t *testing.T) {
+		e := EventFocusLost{}
+
+		if got := e.Data(); got != nil {
+			t.Fatalf("e.Data() = %v", got)
+		}
+	})
+
+	t.Run("EventMouseEnter", func(t *testing.T) {
+		e := EventMouseEnter{}
+
+		if got := e.Data(); got != nil {
+			t.Fatalf("e.Data() = %v", got)
+		}
+	})
+
+	t.Run("EventMouseLeave", func(t *testing.T) {
+		e := EventMouseLeave{}
+
+		if got := e.Data(); got != nil {
+			t.Fatalf("e.Data() = %v", got)
+		}
+	})
+
+	t.Run("EventMinimize", func(t *testing.T) {
+		e := EventMinimize{}
+
+		if got := e.Data(); got != nil {
+			t.Fatalf("e.Data() = %v", got)
+		}
+	})
+
+	t.Run("EventMaximize", func(t *testing.T) {
+		e := EventMaximize{}
+
+		if got := e.Data(); got != nil {
+			t.Fatalf("e.Data() = %v", got)
+		}
+	})
+
+	t.Run("EventRestore", func(t *testing.T) {
+		e := EventRestore{}
+
+		if got := e.Data(); got != nil {
+			t.Fatalf("e.Data() = %v", got)
+		}
+	})
+
+	t.Run("EventMove", func(t *testing.T) {
+		e := EventMove{image.Pt(1, 2)}
+
+		if got, want := e.Data().(image.Point), e.Point; got != want {
+			t.Fatalf("e.Data().(image.Point) = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("EventFrame", func(t *testing.T) {
+		e := EventFrame{Delta: time.Second / 60}
+
+		if got, want := e.Data().(time.Duration), time.Second/60; got != want {
+			t.Fatalf("e.Data().(time.Duration) = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("EventScale", func(t *testing.T) {
+		e := EventScale{1.5}
+
+		if got, want := e.Data().(float64), 1.5; got != want {
+			t.Fatalf("e.Data().(float64) = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("EventMonitorConnected", func(t *testing.T) {
+		e := EventMonitorConnected{"DP-1"}
+
+		if got, want := e.Data().(string), "DP-1"; got != want {
+			t.Fatalf("e.Data().(string) = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("EventMonitorDisconnected", func(t *testing.T) {
+		e := EventMonitorDisconnected{"DP-1"}
+
+		if got, want := e.Data().(string), "DP-1"; got != want {
+			t.Fatalf("e.Data().(string) = %v, want %v", got, want)
+		}
+	})
+
 	t.Run("EventMouseMove", func(t *testing.T) {
 		e := EventMouseMove{image.Pt(1, 2)}
 
@@ -70,6 +213,14 @@ func TestEventData(t *testing.T) {
 		}
 	})
 
+	t.Run("EventMouseRelative", func(t *testing.T) {
+		e := EventMouseRelative{image.Pt(1, -2)}
+
+		if got, want := e.Data().(image.Point), e.Point; got != want {
+			t.Fatalf("e.Data().(image.Point) = %v, want %v", got, want)
+		}
+	})
+
 	t.Run("EventMouseScroll", func(t *testing.T) {
 		e := EventMouseScroll{image.Pt(1, 2)}
 
@@ -79,7 +230,7 @@ func TestEventData(t *testing.T) {
 	})
 
 	t.Run("EventMouseLeftDown", func(t *testing.T) {
-		e := EventMouseLeftDown{image.Pt(1, 2)}
+		e := EventMouseLeftDown{Point: image.Pt(1, 2)}
 
 		if got, want := e.Data().(image.Point), e.Point; got != want {
 			t.Fatalf("e.Data().(image.Point) = %v, want %v", got, want)
@@ -87,7 +238,7 @@ func TestEventData(t *testing.T) {
 	})
 
 	t.Run("EventMouseLeftUp", func(t *testing.T) {
-		e := EventMouseLeftUp{image.Pt(1, 2)}
+		e := EventMouseLeftUp{Point: image.Pt(1, 2)}
 
 		if got, want := e.Data().(image.Point), e.Point; got != want {
 			t.Fatalf("e.Data().(image.Point) = %v, want %v", got, want)
@@ -95,7 +246,7 @@ func TestEventData(t *testing.T) {
 	})
 
 	t.Run("EventMouseMiddleDown", func(t *testing.T) {
-		e := EventMouseMiddleDown{image.Pt(1, 2)}
+		e := EventMouseMiddleDown{Point: image.Pt(1, 2)}
 
 		if got, want := e.Data().(image.Point), e.Point; got != want {
 			t.Fatalf("e.Data().(image.Point) = %v, want %v", got, want)
@@ -103,7 +254,7 @@ func TestEventData(t *testing.T) {
 	})
 
 	t.Run("EventMouseMiddleUp", func(t *testing.T) {
-		e := EventMouseMiddleUp{image.Pt(1, 2)}
+		e := EventMouseMiddleUp{Point: image.Pt(1, 2)}
 
 		if got, want := e.Data().(image.Point), e.Point; got != want {
 			t.Fatalf("e.Data().(image.Point) = %v, want %v", got, want)
@@ -111,7 +262,7 @@ func TestEventData(t *testing.T) {
 	})
 
 	t.Run("EventMouseRightDown", func(t *testing.T) {
-		e := EventMouseRightDown{image.Pt(1, 2)}
+		e := EventMouseRightDown{Point: image.Pt(1, 2)}
 
 		if got, want := e.Data().(image.Point), e.Point; got != want {
 			t.Fatalf("e.Data().(image.Point) = %v, want %v", got, want)
@@ -119,13 +270,21 @@ func TestEventData(t *testing.T) {
 	})
 
 	t.Run("EventMouseRightUp", func(t *testing.T) {
-		e := EventMouseRightUp{image.Pt(1, 2)}
+		e := EventMouseRightUp{Point: image.Pt(1, 2)}
 
 		if got, want := e.Data().(image.Point), e.Point; got != want {
 			t.Fatalf("e.Data().(image.Point) = %v, want %v", got, want)
 		}
 	})
 
+	t.Run("EventDrop", func(t *testing.T) {
+		e := EventDrop{image.Pt(1, 2), []string{"a.png", "b.png"}}
+
+		if got, want := e.Data().([]string), e.Paths; !reflect.DeepEqual(got, want) {
+			t.Fatalf("e.Data().([]string) = %v, want %v", got, want)
+		}
+	})
+
 	t.Run("EventKeyboardChar", func(t *testing.T) {
 		e := EventKeyboardChar{'x'}
 
@@ -135,7 +294,7 @@ func TestEventData(t *testing.T) {
 	})
 
 	t.Run("EventKeyboardDown", func(t *testing.T) {
-		e := EventKeyboardDown{"escape"}
+		e := EventKeyboardDown{Key: "escape"}
 
 		if got, want := e.Data().(string), "escape"; got != want {
 			t.Fatalf("e.Data().(string) = %v, want %v", got, want)
@@ -143,7 +302,7 @@ func TestEventData(t *testing.T) {
 	})
 
 	t.Run("EventKeyboardUp", func(t *testing.T) {
-		e := EventKeyboardUp{"escape"}
+		e := EventKeyboardUp{Key: "escape"}
 
 		if got, want := e.Data().(string), "escape"; got != want {
 			t.Fatalf("e.Data().(string) = %v, want %v", got, want)
@@ -151,7 +310,7 @@ func TestEventData(t *testing.T) {
 	})
 
 	t.Run("EventKeyboardRepeat", func(t *testing.T) {
-		e := EventKeyboardRepeat{"escape"}
+		e := EventKeyboardRepeat{Key: "escape"}
 
 		if got, want := e.Data().(string), "escape"; got != want {
 			t.Fatalf("e.Data().(string) = %v, want %v", got, want)
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"sync"
+
+	"github.com/peterhellberg/gui"
+)
+
+func main() {
+	gui.Run(func() {
+		var wg sync.WaitGroup
+
+		for i, c := range []color.RGBA{
+			{255, 0, 0, 255},
+			{0, 0, 255, 255},
+		} {
+			win, err := gui.Open(
+				gui.Title("gui-windows"),
+				gui.Size(320, 240),
+				gui.Position(100+i*360, 100),
+			)
+			if err != nil {
+				panic(err)
+			}
+
+			wg.Add(1)
+
+			go func(c color.Color) {
+				defer wg.Done()
+				loop(win, c)
+			}(c)
+		}
+
+		wg.Wait()
+	})
+}
+
+func loop(win *gui.Window, c color.Color) {
+	for event := range win.Events() {
+		switch event.(type) {
+		case gui.EventClose:
+			win.Close()
+		case gui.EventResize:
+			win.Draw(func(dst draw.Image) image.Rectangle {
+				draw.Draw(dst, dst.Bounds(), image.NewUniform(c), image.ZP, draw.Src)
+
+				return dst.Bounds()
+			})
+		}
+	}
+}
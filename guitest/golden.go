@@ -0,0 +1,122 @@
+package guitest
+
+import (
+	"flag"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/peterhellberg/gui"
+)
+
+var update = flag.Bool("guitest.update", false, "update the golden images of guitest")
+
+// GoldenDir is the directory containing the golden images.
+var GoldenDir = "testdata"
+
+// AssertGolden compares img against the golden PNG file with the given name
+// in GoldenDir, allowing each color channel to differ by tolerance.
+//
+// If the images differ, img and an image highlighting the differing pixels
+// are written next to the golden file. If the -guitest.update flag is given,
+// the golden file is written instead.
+func AssertGolden(t testing.TB, name string, img image.Image, tolerance uint8) {
+	t.Helper()
+
+	path := filepath.Join(GoldenDir, name+".png")
+
+	if *update {
+		if err := os.MkdirAll(GoldenDir, 0755); err != nil {
+			t.Fatalf("guitest: %v", err)
+		}
+
+		if err := gui.WritePNG(path, img); err != nil {
+			t.Fatalf("guitest: %v", err)
+		}
+
+		t.Logf("guitest: updated %s", path)
+
+		return
+	}
+
+	want, err := readPNG(path)
+	if err != nil {
+		t.Fatalf("guitest: %v (run with -guitest.update to create it)", err)
+	}
+
+	if got, want := img.Bounds(), want.Bounds(); got != want {
+		t.Fatalf("guitest: bounds = %v, want %v as in %s", got, want, path)
+	}
+
+	diff, n := Compare(img, want, tolerance)
+	if n == 0 {
+		return
+	}
+
+	gotPath := filepath.Join(GoldenDir, name+".got.png")
+	diffPath := filepath.Join(GoldenDir, name+".diff.png")
+
+	if err := gui.WritePNG(gotPath, img); err != nil {
+		t.Errorf("guitest: %v", err)
+	}
+
+	if err := gui.WritePNG(diffPath, diff); err != nil {
+		t.Errorf("guitest: %v", err)
+	}
+
+	t.Fatalf("guitest: %d pixels differ from %s, see %s and %s", n, path, gotPath, diffPath)
+}
+
+// Compare returns the number of pixels in a and b where any color channel
+// differs by more than tolerance, and an image where those pixels are red,
+// and the other pixels are a faded version of b.
+//
+// Both images are compared within the bounds of a.
+func Compare(a, b image.Image, tolerance uint8) (*image.RGBA, int) {
+	bounds := a.Bounds()
+	diff := image.NewRGBA(bounds)
+
+	var n int
+
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			ca := color.RGBAModel.Convert(a.At(x, y)).(color.RGBA)
+			cb := color.RGBAModel.Convert(b.At(x, y)).(color.RGBA)
+
+			if exceeds(ca.R, cb.R, tolerance) || exceeds(ca.G, cb.G, tolerance) ||
+				exceeds(ca.B, cb.B, tolerance) || exceeds(ca.A, cb.A, tolerance) {
+				diff.SetRGBA(x, y, color.RGBA{255, 0, 0, 255})
+				n++
+
+				continue
+			}
+
+			g := uint8((uint16(cb.R) + uint16(cb.G) + uint16(cb.B)) / 3)
+
+			diff.SetRGBA(x, y, color.RGBA{g / 4, g / 4, g / 4, 255})
+		}
+	}
+
+	return diff, n
+}
+
+func exceeds(a, b, tolerance uint8) bool {
+	if a > b {
+		return a-b > tolerance
+	}
+
+	return b-a > tolerance
+}
+
+func readPNG(path string) (image.Image, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return png.Decode(f)
+}
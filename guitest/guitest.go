@@ -0,0 +1,193 @@
+// Package guitest drives gui applications in tests, using a headless Env,
+// and compares the resulting images against golden PNG files.
+package guitest
+
+import (
+	"image"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/peterhellberg/gui"
+)
+
+var (
+	// SettleInterval is the time without draws or queued events
+	// after which the draw loop is considered to have settled.
+	//
+	// Events that the application has received, but not yet finished
+	// handling, are not tracked. An application that takes longer than
+	// SettleInterval to draw after receiving an event is considered to
+	// have settled too early, so the interval should then be increased.
+	SettleInterval = 20 * time.Millisecond
+
+	// Timeout is the maximum time to wait for the draw loop
+	// to settle, or for the application to return.
+	Timeout = 5 * time.Second
+)
+
+// Harness runs an application with a headless Env, and drives it with
+// scripted events.
+type Harness struct {
+	t    testing.TB
+	h    *gui.Headless
+	env  *env
+	done chan struct{}
+}
+
+// New starts app in a new goroutine with a headless Env created using the
+// supplied options. The Harness should be closed when the test is done.
+func New(t testing.TB, app func(gui.Env), opts ...gui.Option) *Harness {
+	h := gui.NewHeadless(opts...)
+
+	hr := &Harness{
+		t:    t,
+		h:    h,
+		env:  &env{Headless: h},
+		done: make(chan struct{}),
+	}
+
+	go func() {
+		defer close(hr.done)
+		app(hr.env)
+	}()
+
+	return hr
+}
+
+// Send an event to the application.
+func (hr *Harness) Send(events ...gui.Event) {
+	hr.env.mu.Lock()
+	defer hr.env.mu.Unlock()
+
+	if hr.env.closed {
+		hr.t.Fatalf("guitest: send to closed Env")
+	}
+
+	for _, e := range events {
+		hr.h.Send(e)
+	}
+}
+
+// Click moves the mouse to p and clicks the left mouse button.
+func (hr *Harness) Click(p image.Point) {
+	hr.Send(
+		gui.EventMouseMove{Point: p},
+		gui.EventMouseLeftDown{Point: p},
+		gui.EventMouseLeftUp{Point: p},
+	)
+}
+
+// Type sends an EventKeyboardChar for each rune in s.
+func (hr *Harness) Type(s string) {
+	for _, r := range s {
+		hr.Send(gui.EventKeyboardChar{Char: r})
+	}
+}
+
+// Key presses and releases the key with the given name, such as "escape".
+func (hr *Harness) Key(name string) {
+	hr.Send(
+		gui.EventKeyboardDown{Key: name},
+		gui.EventKeyboardUp{Key: name},
+	)
+}
+
+// Resize the image of the Env, followed by an EventResize.
+func (hr *Harness) Resize(width, height int) {
+	hr.env.mu.Lock()
+	defer hr.env.mu.Unlock()
+
+	if hr.env.closed {
+		hr.t.Fatalf("guitest: resize of closed Env")
+	}
+
+	hr.h.Resize(width, height)
+}
+
+// Settle waits until there are no queued events and no draws
+// have happened for SettleInterval, failing the test after Timeout.
+// See SettleInterval for the events that are still being handled.
+func (hr *Harness) Settle() {
+	hr.t.Helper()
+
+	deadline := time.Now().Add(Timeout)
+	last := hr.h.Stats()
+
+	for {
+		time.Sleep(SettleInterval)
+
+		s := hr.h.Stats()
+
+		if s.QueueDepth == 0 && s.Draws == last.Draws {
+			return
+		}
+
+		if time.Now().After(deadline) {
+			hr.t.Fatalf("guitest: draw loop did not settle within %v", Timeout)
+		}
+
+		last = s
+	}
+}
+
+// Image waits for the draw loop to settle, and returns a copy of the image.
+func (hr *Harness) Image() *image.RGBA {
+	hr.t.Helper()
+
+	hr.Settle()
+
+	img := hr.h.Image()
+	if img == nil {
+		hr.t.Fatalf("guitest: image of closed Env")
+	}
+
+	return img
+}
+
+// AssertGolden waits for the draw loop to settle, and compares
+// the image against the golden file with the given name.
+func (hr *Harness) AssertGolden(name string, tolerance uint8) {
+	hr.t.Helper()
+
+	AssertGolden(hr.t, name, hr.Image(), tolerance)
+}
+
+// Close sends an EventClose to the application and waits for it to return,
+// then the Env is closed, if the application did not close it.
+func (hr *Harness) Close() {
+	hr.t.Helper()
+
+	hr.env.mu.Lock()
+	if !hr.env.closed {
+		hr.h.Send(gui.EventClose{})
+	}
+	hr.env.mu.Unlock()
+
+	select {
+	case <-hr.done:
+	case <-time.After(Timeout):
+		hr.t.Errorf("guitest: application did not return within %v", Timeout)
+	}
+
+	hr.env.Close()
+}
+
+// env is the Env given to the application, it
+// can be closed by both the application and Close.
+type env struct {
+	*gui.Headless
+
+	mu     sync.Mutex
+	closed bool
+}
+
+func (e *env) Close() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if !e.closed {
+		e.closed = true
+		e.Headless.Close()
+	}
+}
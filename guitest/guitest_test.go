@@ -0,0 +1,90 @@
+package guitest
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"testing"
+
+	"github.com/peterhellberg/gui"
+)
+
+func testApp(env gui.Env) {
+	bg := image.NewUniform(color.RGBA{32, 32, 64, 255})
+	fg := image.NewUniform(color.RGBA{255, 200, 0, 255})
+
+	for event := range env.Events() {
+		switch event := event.(type) {
+		case gui.EventClose:
+			env.Close()
+		case gui.EventResize:
+			env.Draw(func(dst draw.Image) image.Rectangle {
+				draw.Draw(dst, dst.Bounds(), bg, image.ZP, draw.Src)
+
+				return dst.Bounds()
+			})
+		case gui.EventMouseLeftDown:
+			env.Draw(func(dst draw.Image) image.Rectangle {
+				r := image.Rectangle{event.Point, event.Point.Add(image.Pt(4, 4))}
+
+				draw.Draw(dst, r, fg, image.ZP, draw.Src)
+
+				return r
+			})
+		case gui.EventKeyboardChar:
+			if event.Char == 'c' {
+				env.Draw(func(dst draw.Image) image.Rectangle {
+					draw.Draw(dst, dst.Bounds(), bg, image.ZP, draw.Src)
+
+					return dst.Bounds()
+				})
+			}
+		}
+	}
+}
+
+func TestHarness(t *testing.T) {
+	h := New(t, testApp, gui.Size(16, 16))
+	defer h.Close()
+
+	h.Click(image.Pt(2, 2))
+	h.Click(image.Pt(10, 6))
+
+	h.AssertGolden("clicks", 0)
+
+	h.Type("c")
+
+	if got, want := h.Image().At(3, 3), (color.RGBA{32, 32, 64, 255}); got != want {
+		t.Fatalf("h.Image().At(3, 3) = %v, want %v", got, want)
+	}
+
+	h.Resize(8, 4)
+
+	if got, want := h.Image().Bounds(), image.Rect(0, 0, 8, 4); got != want {
+		t.Fatalf("h.Image().Bounds() = %v, want %v", got, want)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	a := image.NewRGBA(image.Rect(0, 0, 2, 2))
+	b := image.NewRGBA(image.Rect(0, 0, 2, 2))
+
+	a.Set(0, 0, color.RGBA{10, 0, 0, 255})
+	b.Set(0, 0, color.RGBA{12, 0, 0, 255})
+	a.Set(1, 1, color.RGBA{0, 0, 0, 255})
+	b.Set(1, 1, color.RGBA{0, 0, 200, 255})
+
+	diff, n := Compare(a, b, 2)
+
+	if got, want := n, 1; got != want {
+		t.Fatalf("n = %d, want %d", got, want)
+	}
+
+	if got, want := diff.At(1, 1), (color.RGBA{255, 0, 0, 255}); got != want {
+		t.Fatalf("diff.At(1, 1) = %v, want %v", got, want)
+	}
+
+	if _, n := Compare(a, b, 0); n != 2 {
+		t.Fatalf("n = %d, want 2", n)
+	}
+}
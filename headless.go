@@ -0,0 +1,228 @@
+package gui
+
+import (
+	"image"
+	"image/draw"
+	"sync"
+	"time"
+)
+
+// Headless is an Env that is not backed by an actual graphical window.
+//
+// It draws into an in-memory image, which makes it useful when running
+// on machines without a display or GPU, such as CI servers.
+type Headless struct {
+	out   <-chan Event
+	in    chan<- Event
+	queue QueueConfig
+	draw  chan func(draw.Image) image.Rectangle
+
+	newSize  chan image.Rectangle
+	snapshot chan chan *image.RGBA
+	damage   chan chan image.Rectangle
+	finish   chan struct{}
+
+	// senders are goroutines sending to in, that
+	// need to stop before in can be closed.
+	senders sync.WaitGroup
+
+	// inMu is read locked by Resize, in order for in to not be
+	// closed while it is sending, since it is not one of senders.
+	inMu sync.RWMutex
+
+	img   *image.RGBA
+	stats *stats
+
+	clipboard struct {
+		sync.Mutex
+		text string
+		img  image.Image
+	}
+}
+
+// NewHeadless creates a new headless Env with all the supplied options.
+//
+// The default size is 640x480, options that only apply to
+// an actual window, such as the title, are ignored.
+// EventUpdate is sent at the rate set by the UpdateRate option.
+func NewHeadless(opts ...Option) *Headless {
+	o := newOptions(opts...)
+	h := newHeadless(image.Rect(0, 0, o.width, o.height), o.queue)
+
+	h.in <- EventResize{h.img.Bounds()}
+
+	if o.updateRate > 0 {
+		h.senders.Add(1)
+
+		go func() {
+			defer h.senders.Done()
+			sendUpdates(o.updateRate, h.in, h.finish)
+		}()
+	}
+
+	return h
+}
+
+func newHeadless(r image.Rectangle, q QueueConfig) *Headless {
+	h := &Headless{
+		queue:    q,
+		draw:     make(chan func(draw.Image) image.Rectangle),
+		newSize:  make(chan image.Rectangle),
+		snapshot: make(chan chan *image.RGBA),
+		damage:   make(chan chan image.Rectangle),
+		finish:   make(chan struct{}),
+		img:      image.NewRGBA(r),
+		stats:    &stats{},
+	}
+
+	h.out, h.in = makeCountedEventsChan(q, &h.stats.queueDepth, &h.stats.dropped)
+
+	go h.drawThread()
+
+	return h
+}
+
+// Send an event to the events channel of the headless Env.
+func (h *Headless) Send(e Event) {
+	h.in <- e
+}
+
+// Resize the image of the headless Env, followed by an EventResize.
+// It does nothing once the headless Env has been closed.
+func (h *Headless) Resize(width, height int) {
+	r := image.Rect(0, 0, width, height)
+
+	h.inMu.RLock()
+	defer h.inMu.RUnlock()
+
+	select {
+	case h.newSize <- r:
+	case <-h.finish:
+		return
+	}
+
+	select {
+	case h.in <- EventResize{r}:
+	case <-h.finish:
+	}
+}
+
+// Image returns a copy of the current image of the headless Env.
+func (h *Headless) Image() *image.RGBA {
+	ch := make(chan *image.RGBA)
+
+	select {
+	case h.snapshot <- ch:
+		return <-ch
+	case <-h.finish:
+		return nil
+	}
+}
+
+// Damage returns the union of all rectangles damaged by resizing
+// or drawing since the previous call to Damage.
+func (h *Headless) Damage() image.Rectangle {
+	ch := make(chan image.Rectangle)
+
+	select {
+	case h.damage <- ch:
+		return <-ch
+	case <-h.finish:
+		return image.ZR
+	}
+}
+
+// ClipboardText returns the text contents of the in-memory clipboard.
+func (h *Headless) ClipboardText() string {
+	h.clipboard.Lock()
+	defer h.clipboard.Unlock()
+
+	return h.clipboard.text
+}
+
+// SetClipboardText sets the in-memory clipboard to the text.
+func (h *Headless) SetClipboardText(text string) {
+	h.clipboard.Lock()
+	defer h.clipboard.Unlock()
+
+	h.clipboard.text = text
+}
+
+// ClipboardImage returns the image contents of the in-memory clipboard.
+func (h *Headless) ClipboardImage() image.Image {
+	h.clipboard.Lock()
+	defer h.clipboard.Unlock()
+
+	return h.clipboard.img
+}
+
+// SetClipboardImage sets the in-memory clipboard to the image.
+func (h *Headless) SetClipboardImage(img image.Image) {
+	h.clipboard.Lock()
+	defer h.clipboard.Unlock()
+
+	h.clipboard.img = img
+}
+
+// Stats returns a snapshot of the performance counters of the headless Env,
+// only the draws and the queue depth are counted since nothing is flushed.
+func (h *Headless) Stats() Stats {
+	return h.stats.snapshot(time.Now())
+}
+
+func (h *Headless) eventQueue() QueueConfig { return h.queue }
+
+// Events returns the events channel of the headless Env.
+func (h *Headless) Events() <-chan Event { return h.out }
+
+// Draw to the image of the headless Env using the provided function.
+func (h *Headless) Draw(fn func(draw.Image) image.Rectangle) {
+	h.draw <- fn
+}
+
+// Close closes the draw channel
+func (h *Headless) Close() {
+	close(h.draw)
+}
+
+func (h *Headless) drawThread() {
+	var totalR image.Rectangle
+
+	for {
+		select {
+		case r := <-h.newSize:
+			img := image.NewRGBA(r)
+			draw.Draw(img, h.img.Bounds(), h.img, h.img.Bounds().Min, draw.Src)
+			h.img = img
+			totalR = totalR.Union(r)
+
+		case d, ok := <-h.draw:
+			if !ok {
+				close(h.finish)
+				h.senders.Wait()
+				h.inMu.Lock()
+				close(h.in)
+				h.inMu.Unlock()
+				return
+			}
+			r := d(h.img)
+			totalR = totalR.Union(r)
+			h.stats.draw(time.Now())
+
+		case ch := <-h.snapshot:
+			ch <- copyRGBA(h.img)
+
+		case ch := <-h.damage:
+			ch <- totalR
+			totalR = image.ZR
+		}
+	}
+}
+
+func copyRGBA(src *image.RGBA) *image.RGBA {
+	dst := image.NewRGBA(src.Bounds())
+
+	copy(dst.Pix, src.Pix)
+
+	return dst
+}
@@ -0,0 +1,179 @@
+package gui
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"testing"
+	"time"
+)
+
+func TestHeadlessIsEnv(t *testing.T) {
+	var _ Env = &Headless{}
+}
+
+func TestNewHeadless(t *testing.T) {
+	h := NewHeadless(Size(100, 200))
+	defer h.Close()
+
+	e, ok := (<-h.Events()).(EventResize)
+	if !ok {
+		t.Fatalf("expected initial EventResize")
+	}
+
+	if got, want := e.Rectangle, image.Rect(0, 0, 100, 200); got != want {
+		t.Fatalf("e.Rectangle = %v, want %v", got, want)
+	}
+}
+
+func TestHeadlessSend(t *testing.T) {
+	h := NewHeadless()
+	defer h.Close()
+
+	<-h.Events()
+
+	h.Send(EventKeyboardChar{'x'})
+
+	if got, want := (<-h.Events()).Name(), "keyboard/char"; got != want {
+		t.Fatalf("e.Name() = %q, want %q", got, want)
+	}
+}
+
+func TestHeadlessDraw(t *testing.T) {
+	h := NewHeadless(Size(10, 10))
+	defer h.Close()
+
+	red := color.RGBA{255, 0, 0, 255}
+
+	h.Draw(func(dst draw.Image) image.Rectangle {
+		r := image.Rect(0, 0, 2, 2)
+
+		draw.Draw(dst, r, image.NewUniform(red), image.ZP, draw.Src)
+
+		return r
+	})
+
+	h.Draw(func(dst draw.Image) image.Rectangle {
+		return image.Rect(5, 5, 6, 6)
+	})
+
+	if got, want := h.Damage(), image.Rect(0, 0, 6, 6); got != want {
+		t.Fatalf("h.Damage() = %v, want %v", got, want)
+	}
+
+	if got := h.Damage(); !got.Empty() {
+		t.Fatalf("h.Damage() = %v, want empty rectangle", got)
+	}
+
+	img := h.Image()
+
+	if got, want := img.At(1, 1), red; got != want {
+		t.Fatalf("img.At(1, 1) = %v, want %v", got, want)
+	}
+
+	if got, want := img.At(2, 2), (color.RGBA{}); got != want {
+		t.Fatalf("img.At(2, 2) = %v, want %v", got, want)
+	}
+}
+
+func TestHeadlessResize(t *testing.T) {
+	h := NewHeadless(Size(10, 10))
+	defer h.Close()
+
+	<-h.Events()
+
+	h.Resize(20, 30)
+
+	e := (<-h.Events()).(EventResize)
+
+	if got, want := e.Rectangle, image.Rect(0, 0, 20, 30); got != want {
+		t.Fatalf("e.Rectangle = %v, want %v", got, want)
+	}
+
+	if got, want := h.Image().Bounds(), e.Rectangle; got != want {
+		t.Fatalf("h.Image().Bounds() = %v, want %v", got, want)
+	}
+}
+
+func TestHeadlessClose(t *testing.T) {
+	h := NewHeadless()
+	h.Close()
+
+	for range h.Events() {
+	}
+
+	if got := h.Image(); got != nil {
+		t.Fatalf("h.Image() = %v, want nil", got)
+	}
+}
+
+func TestHeadlessResizeAfterClose(t *testing.T) {
+	h := NewHeadless()
+	h.Close()
+
+	done := make(chan struct{})
+
+	go func() {
+		h.Resize(20, 30)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatalf("h.Resize blocked after h.Close")
+	}
+
+	for range h.Events() {
+	}
+}
+
+func TestHeadlessClipboard(t *testing.T) {
+	var (
+		_ Clipboarder      = &Headless{}
+		_ ImageClipboarder = &Headless{}
+	)
+
+	h := NewHeadless()
+	defer h.Close()
+
+	if got := h.ClipboardText(); got != "" {
+		t.Fatalf("h.ClipboardText() = %q, want empty string", got)
+	}
+
+	h.SetClipboardText("test-text")
+
+	if got, want := h.ClipboardText(), "test-text"; got != want {
+		t.Fatalf("h.ClipboardText() = %q, want %q", got, want)
+	}
+
+	if got := h.ClipboardImage(); got != nil {
+		t.Fatalf("h.ClipboardImage() = %v, want nil", got)
+	}
+
+	img := image.NewRGBA(image.Rect(0, 0, 1, 1))
+
+	h.SetClipboardImage(img)
+
+	if got, want := h.ClipboardImage(), image.Image(img); got != want {
+		t.Fatalf("h.ClipboardImage() = %v, want %v", got, want)
+	}
+}
+
+func TestHeadlessStats(t *testing.T) {
+	h := NewHeadless()
+	defer h.Close()
+
+	for i := 0; i < 2; i++ {
+		h.Draw(func(dst draw.Image) image.Rectangle {
+			return dst.Bounds()
+		})
+	}
+
+	// Wait for the last draw to finish
+	h.Image()
+
+	if got, want := h.Stats().Draws, 2; got != want {
+		t.Fatalf("h.Stats().Draws = %d, want %d", got, want)
+	}
+}
@@ -0,0 +1,37 @@
+package gui
+
+import "strings"
+
+// Modifiers is a set of modifier keys, held down or locked,
+// at the time of a keyboard or mouse button event.
+type Modifiers int
+
+// The modifier keys
+const (
+	ModShift Modifiers = 1 << iota
+	ModCtrl
+	ModAlt
+	ModSuper
+	ModCapsLock
+	ModNumLock
+)
+
+var modifierNames = []string{"shift", "ctrl", "alt", "super", "capslock", "numlock"}
+
+// Has reports whether all of the given modifiers are in the set.
+func (m Modifiers) Has(mods Modifiers) bool {
+	return m&mods == mods
+}
+
+// String returns the names of the modifiers joined by "+", such as "shift+ctrl".
+func (m Modifiers) String() string {
+	var names []string
+
+	for i, name := range modifierNames {
+		if m&(1<<uint(i)) != 0 {
+			names = append(names, name)
+		}
+	}
+
+	return strings.Join(names, "+")
+}
@@ -0,0 +1,38 @@
+package gui
+
+import "testing"
+
+func TestModifiersHas(t *testing.T) {
+	m := ModCtrl | ModShift
+
+	for _, tt := range []struct {
+		mods Modifiers
+		want bool
+	}{
+		{ModCtrl, true},
+		{ModShift, true},
+		{ModCtrl | ModShift, true},
+		{ModAlt, false},
+		{ModCtrl | ModAlt, false},
+	} {
+		if got := m.Has(tt.mods); got != tt.want {
+			t.Fatalf("m.Has(%v) = %v, want %v", tt.mods, got, tt.want)
+		}
+	}
+}
+
+func TestModifiersString(t *testing.T) {
+	for _, tt := range []struct {
+		mods Modifiers
+		want string
+	}{
+		{0, ""},
+		{ModShift, "shift"},
+		{ModCtrl | ModShift, "shift+ctrl"},
+		{ModSuper | ModCapsLock | ModNumLock, "super+capslock+numlock"},
+	} {
+		if got := tt.mods.String(); got != tt.want {
+			t.Fatalf("tt.mods.String() = %q, want %q", got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package gui
+
+import (
+	"image"
+
+	"github.com/go-gl/glfw/v3.3/glfw"
+)
+
+// Monitor describes a monitor connected to the computer.
+//
+// Positions and sizes, including those of the video modes,
+// are in screen coordinates.
+type Monitor struct {
+	Name    string
+	Primary bool
+
+	// Bounds of the monitor in the virtual screen, it is
+	// empty if the current video mode could not be read.
+	Bounds image.Rectangle
+
+	// Workarea is the part of the monitor not
+	// occupied by task bars, menu bars and docks.
+	Workarea image.Rectangle
+
+	// PhysicalSize of the monitor, in millimeters.
+	PhysicalSize image.Point
+
+	// ContentScale is the ratio between the current DPI and the platform default DPI.
+	ContentScaleX, ContentScaleY float64
+
+	// Mode is the current video mode, and Modes are all supported video modes.
+	Mode  VideoMode
+	Modes []VideoMode
+}
+
+// VideoMode describes a video mode of a monitor.
+type VideoMode struct {
+	Width, Height int
+	RefreshRate   int
+	BitDepth      int
+}
+
+// Monitors returns all connected monitors, the primary monitor first.
+//
+// The index of a monitor in the returned slice is the index
+// expected by the Fullscreen method of Window.
+func Monitors() ([]Monitor, error) {
+	var (
+		monitors []Monitor
+		err      error
+	)
+
+	call(func() {
+		if err = initGLFW(); err != nil {
+			return
+		}
+
+		for i, m := range glfw.GetMonitors() {
+			monitors = append(monitors, makeMonitor(m, i == 0))
+		}
+	})
+
+	return monitors, err
+}
+
+func makeMonitor(m *glfw.Monitor, primary bool) Monitor {
+	x, y := m.GetPos()
+	wx, wy, ww, wh := m.GetWorkarea()
+	pw, ph := m.GetPhysicalSize()
+	sx, sy := m.GetContentScale()
+	mode := makeVideoMode(m.GetVideoMode())
+
+	var modes []VideoMode
+
+	for _, vm := range m.GetVideoModes() {
+		modes = append(modes, makeVideoMode(vm))
+	}
+
+	return Monitor{
+		Name:          m.GetName(),
+		Primary:       primary,
+		Bounds:        image.Rect(x, y, x+mode.Width, y+mode.Height),
+		Workarea:      image.Rect(wx, wy, wx+ww, wy+wh),
+		PhysicalSize:  image.Pt(pw, ph),
+		ContentScaleX: float64(sx),
+		ContentScaleY: float64(sy),
+		Mode:          mode,
+		Modes:         modes,
+	}
+}
+
+// makeVideoMode returns the zero VideoMode if vm is nil,
+// which GLFW returns for a video mode that cannot be read.
+func makeVideoMode(vm *glfw.VidMode) VideoMode {
+	if vm == nil {
+		return VideoMode{}
+	}
+
+	return VideoMode{
+		Width:       vm.Width,
+		Height:      vm.Height,
+		RefreshRate: vm.RefreshRate,
+		BitDepth:    vm.RedBits + vm.GreenBits + vm.BlueBits,
+	}
+}
+
+// monitorCallback sends monitor events to all windows,
+// it is called by GLFW on the main thread.
+func monitorCallback(m *glfw.Monitor, event glfw.PeripheralEvent) {
+	var e Event
+
+	switch event {
+	case glfw.Connected:
+		e = EventMonitorConnected{m.GetName()}
+	case glfw.Disconnected:
+		e = EventMonitorDisconnected{m.GetName()}
+	default:
+		return
+	}
+
+	pump.Lock()
+	defer pump.Unlock()
+
+	for w := range pump.windows {
+		w.in <- e
+	}
+}
@@ -0,0 +1,26 @@
+package gui
+
+import (
+	"testing"
+
+	"github.com/go-gl/glfw/v3.3/glfw"
+)
+
+func TestMakeVideoMode(t *testing.T) {
+	vm := makeVideoMode(&glfw.VidMode{
+		Width:       1920,
+		Height:      1080,
+		RedBits:     8,
+		GreenBits:   8,
+		BlueBits:    8,
+		RefreshRate: 60,
+	})
+
+	if got, want := vm, (VideoMode{Width: 1920, Height: 1080, RefreshRate: 60, BitDepth: 24}); got != want {
+		t.Fatalf("makeVideoMode() = %+v, want %+v", got, want)
+	}
+
+	if got, want := makeVideoMode(nil), (VideoMode{}); got != want {
+		t.Fatalf("makeVideoMode(nil) = %+v, want %+v", got, want)
+	}
+}
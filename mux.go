@@ -4,6 +4,8 @@ import (
 	"image"
 	"image/draw"
 	"sync"
+	"sync/atomic"
+	"time"
 )
 
 // Mux can be used to multiplex an Env.
@@ -11,19 +13,35 @@ type Mux struct {
 	mu         sync.Mutex
 	lastResize Event
 	eventsIns  []chan<- Event
+	depths     map[chan<- Event]*int64
 	draw       chan<- func(draw.Image) image.Rectangle
+
+	env   Env
+	queue QueueConfig
+	stats *stats
 }
 
 // NewMux creates a new Mux that multiplexes the given Env.
+//
+// The event queues of the virtual Envs are configured like the queue of the
+// multiplexed Env, such as by the EventQueue option, except that QueueBlock
+// is replaced by QueueDropOldest, since a slow virtual Env would otherwise
+// make all of the other virtual Envs wait.
 func NewMux(env Env) (mux *Mux, master Env) {
 	drawChan := make(chan func(draw.Image) image.Rectangle)
 
-	mux = &Mux{draw: drawChan}
+	q := eventQueueOf(env)
+	if q.Policy == QueueBlock {
+		q.Policy = QueueDropOldest
+	}
+
+	mux = &Mux{draw: drawChan, env: env, queue: q, stats: &stats{}}
 	master = mux.makeEnv(true)
 
 	go func() {
 		for d := range drawChan {
 			env.Draw(d)
+			mux.stats.draw(time.Now())
 		}
 
 		env.Close()
@@ -55,6 +73,33 @@ func NewMux(env Env) (mux *Mux, master Env) {
 	return mux, master
 }
 
+// Stats returns a snapshot of the performance counters of the Mux.
+//
+// The draws are counted by the Mux, while the flushes are taken from the
+// multiplexed Env if it has a Stats method, like Window. The queue depth and
+// the dropped events are the sums for the multiplexed Env and all virtual Envs.
+func (mux *Mux) Stats() Stats {
+	var s Stats
+
+	if env, ok := mux.env.(interface{ Stats() Stats }); ok {
+		s = env.Stats()
+	}
+
+	d := mux.stats.snapshot(time.Now())
+
+	s.Draws = d.Draws
+	s.DrawsPerSecond = d.DrawsPerSecond
+	s.DroppedEvents += d.DroppedEvents
+
+	mux.mu.Lock()
+	for _, depth := range mux.depths {
+		s.QueueDepth += int(atomic.LoadInt64(depth))
+	}
+	mux.mu.Unlock()
+
+	return s
+}
+
 // Env creates a new virtual Env that interacts with the root Env of the Mux.
 func (mux *Mux) Env() Env {
 	return mux.makeEnv(false)
@@ -63,6 +108,7 @@ func (mux *Mux) Env() Env {
 type muxEnv struct {
 	events <-chan Event
 	draw   chan<- func(draw.Image) image.Rectangle
+	queue  QueueConfig
 }
 
 func (m *muxEnv) Events() <-chan Event {
@@ -77,14 +123,27 @@ func (m *muxEnv) Close() {
 	close(m.draw)
 }
 
+func (m *muxEnv) eventQueue() QueueConfig { return m.queue }
+
 func (mux *Mux) makeEnv(master bool) Env {
-	eventsOut, eventsIn := makeEventsChan()
+	depth := new(int64)
+
+	var dropped *int64
+	if mux.stats != nil {
+		dropped = &mux.stats.dropped
+	}
+
+	eventsOut, eventsIn := makeCountedEventsChan(mux.queue, depth, dropped)
 	drawChan := make(chan func(draw.Image) image.Rectangle)
 
-	env := &muxEnv{eventsOut, drawChan}
+	env := &muxEnv{eventsOut, drawChan, mux.queue}
 
 	mux.mu.Lock()
 	mux.eventsIns = append(mux.eventsIns, eventsIn)
+	if mux.depths == nil {
+		mux.depths = map[chan<- Event]*int64{}
+	}
+	mux.depths[eventsIn] = depth
 	if mux.lastResize != nil {
 		eventsIn <- mux.lastResize
 	}
@@ -110,6 +169,7 @@ func (mux *Mux) makeEnv(master bool) Env {
 				close(eventsIn)
 			}
 			mux.eventsIns = nil
+			mux.depths = nil
 			close(mux.draw)
 			mux.mu.Unlock()
 		} else {
@@ -123,6 +183,7 @@ func (mux *Mux) makeEnv(master bool) Env {
 			if i != -1 {
 				mux.eventsIns = append(mux.eventsIns[:i], mux.eventsIns[i+1:]...)
 			}
+			delete(mux.depths, eventsIn)
 			mux.mu.Unlock()
 		}
 	}()
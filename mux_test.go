@@ -5,6 +5,7 @@ import (
 	"image/color"
 	"image/draw"
 	"testing"
+	"time"
 )
 
 func TestNewMux(t *testing.T) {
@@ -40,3 +41,99 @@ func TestMuxEnvDraw(t *testing.T) {
 		return dst.Bounds()
 	})
 }
+
+func TestMuxEventDrop(t *testing.T) {
+	h := NewHeadless()
+	mux, master := NewMux(h)
+	env := mux.Env()
+
+	drop := EventDrop{image.Pt(1, 2), []string{"a.png"}}
+
+	h.Send(drop)
+
+	for _, env := range []Env{master, env} {
+		for e := range env.Events() {
+			if e, ok := e.(EventDrop); ok {
+				if got, want := e.Paths[0], drop.Paths[0]; got != want {
+					t.Fatalf("e.Paths[0] = %q, want %q", got, want)
+				}
+
+				break
+			}
+		}
+	}
+
+	master.Close()
+}
+
+func TestMuxStats(t *testing.T) {
+	h := NewHeadless()
+	mux, master := NewMux(h)
+	defer master.Close()
+
+	env := mux.Env()
+
+	for i := 0; i < 3; i++ {
+		env.Draw(func(dst draw.Image) image.Rectangle {
+			return image.Rect(0, 0, 1, 1)
+		})
+	}
+
+	// The last draw may still be in flight to the Headless
+	deadline := time.Now().Add(time.Second)
+
+	for mux.Stats().Draws < 3 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+
+	if got, want := mux.Stats().Draws, 3; got != want {
+		t.Fatalf("mux.Stats().Draws = %d, want %d", got, want)
+	}
+}
+
+func TestMuxSlowConsumer(t *testing.T) {
+	q := QueueConfig{Capacity: 4, Policy: QueueDropOldest}
+	h := NewHeadless(EventQueue(q))
+	mux, master := NewMux(h)
+	env := mux.Env()
+
+	received := make(chan struct{})
+
+	go func() {
+		for e := range master.Events() {
+			if e, ok := e.(EventKeyboardChar); ok && e.Char == 'z' {
+				close(received)
+			}
+		}
+	}()
+
+	for i := 0; i < 100; i++ {
+		h.Send(EventKeyboardChar{'a'})
+	}
+
+	h.Send(EventKeyboardChar{'z'})
+
+	<-received
+
+	// The Mux sends to all virtual Envs while holding its lock,
+	// so the last event has been sent to env once Stats returns
+	if got := mux.Stats().DroppedEvents; got == 0 {
+		t.Fatalf("mux.Stats().DroppedEvents = %d, want > 0", got)
+	}
+
+	var n int
+
+	for e := range env.Events() {
+		n++
+
+		if e == Event(EventKeyboardChar{'z'}) {
+			break
+		}
+	}
+
+	if max := q.Capacity; n > max {
+		t.Fatalf("received %d events, want at most %d", n, max)
+	}
+
+	master.Close()
+}
@@ -0,0 +1,13 @@
+package gui
+
+import "github.com/go-gl/glfw/v3.3/glfw"
+
+// nativeWindow returns the NSWindow of win.
+func nativeWindow(win *glfw.Window) uintptr {
+	return uintptr(win.GetCocoaWindow())
+}
+
+// nativeDisplay returns zero, since there is no display connection on macOS.
+func nativeDisplay() uintptr {
+	return 0
+}
@@ -0,0 +1,20 @@
+//go:build (linux && wayland) || (freebsd && wayland) || (netbsd && wayland) || (openbsd && wayland)
+// +build linux,wayland freebsd,wayland netbsd,wayland openbsd,wayland
+
+package gui
+
+import (
+	"unsafe"
+
+	"github.com/go-gl/glfw/v3.3/glfw"
+)
+
+// nativeWindow returns the wl_surface of win.
+func nativeWindow(win *glfw.Window) uintptr {
+	return uintptr(unsafe.Pointer(win.GetWaylandWindow()))
+}
+
+// nativeDisplay returns the wl_display used by GLFW.
+func nativeDisplay() uintptr {
+	return uintptr(unsafe.Pointer(glfw.GetWaylandDisplay()))
+}
@@ -0,0 +1,17 @@
+package gui
+
+import (
+	"unsafe"
+
+	"github.com/go-gl/glfw/v3.3/glfw"
+)
+
+// nativeWindow returns the HWND of win.
+func nativeWindow(win *glfw.Window) uintptr {
+	return uintptr(unsafe.Pointer(win.GetWin32Window()))
+}
+
+// nativeDisplay returns zero, since there is no display connection on Windows.
+func nativeDisplay() uintptr {
+	return 0
+}
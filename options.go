@@ -1,5 +1,7 @@
 package gui
 
+import "image"
+
 // Option is a functional option to the window constructor New.
 type Option func(*options)
 
@@ -8,6 +10,31 @@ type options struct {
 	width, height int
 	resizable     bool
 	decorated     bool
+	updateRate    int
+	sidedKeys     bool
+
+	minWidth, minHeight      int
+	maxWidth, maxHeight      int
+	aspectNumer, aspectDenom int
+
+	x, y       int
+	positioned bool
+	centered   bool
+
+	floating    bool
+	transparent bool
+	visible     bool
+	icon        image.Image
+
+	presenter Presenter
+
+	frameRate   int
+	immediate   bool
+	vsync       bool
+	frameEvents bool
+	frames      FrameWriter
+
+	queue QueueConfig
 }
 
 func newOptions(opts ...Option) options {
@@ -17,6 +44,8 @@ func newOptions(opts ...Option) options {
 		height:    480,
 		resizable: false,
 		decorated: true,
+		visible:   true,
+		vsync:     true,
 	}
 
 	for _, opt := range opts {
@@ -54,3 +83,156 @@ func Decorated(decorated bool) Option {
 		o.decorated = decorated
 	}
 }
+
+// UpdateRate option makes the window send EventUpdate the given
+// number of times per second, a rate of zero disables updates.
+func UpdateRate(rate int) Option {
+	return func(o *options) {
+		o.updateRate = rate
+	}
+}
+
+// SidedModifierKeys option makes the names of the modifier keys include
+// their side, such as "leftshift" and "rightctrl", instead of "shift" and "ctrl".
+func SidedModifierKeys(sided bool) Option {
+	return func(o *options) {
+		o.sidedKeys = sided
+	}
+}
+
+// MinSize option sets the minimum width and height of the window,
+// zero means that there is no minimum.
+func MinSize(width, height int) Option {
+	return func(o *options) {
+		o.minWidth = width
+		o.minHeight = height
+	}
+}
+
+// MaxSize option sets the maximum width and height of the window,
+// zero means that there is no maximum.
+func MaxSize(width, height int) Option {
+	return func(o *options) {
+		o.maxWidth = width
+		o.maxHeight = height
+	}
+}
+
+// AspectRatio option keeps the aspect ratio of the window fixed when it is
+// resized by the user, such as AspectRatio(16, 9).
+func AspectRatio(numer, denom int) Option {
+	return func(o *options) {
+		o.aspectNumer = numer
+		o.aspectDenom = denom
+	}
+}
+
+// Position option sets the initial position of the window in screen coordinates.
+func Position(x, y int) Option {
+	return func(o *options) {
+		o.x = x
+		o.y = y
+		o.positioned = true
+		o.centered = false
+	}
+}
+
+// Centered option places the window in the center of the primary monitor.
+func Centered() Option {
+	return func(o *options) {
+		o.centered = true
+		o.positioned = false
+	}
+}
+
+// Floating option keeps the window on top of other windows.
+func Floating(floating bool) Option {
+	return func(o *options) {
+		o.floating = floating
+	}
+}
+
+// Transparent option makes the window framebuffer transparent,
+// using the alpha channel of the window image.
+func Transparent(transparent bool) Option {
+	return func(o *options) {
+		o.transparent = transparent
+	}
+}
+
+// Visible option controls if the window should initially be visible,
+// a window that is not visible can be shown using its Show method.
+func Visible(visible bool) Option {
+	return func(o *options) {
+		o.visible = visible
+	}
+}
+
+// Icon option sets the icon of the window.
+func Icon(img image.Image) Option {
+	return func(o *options) {
+		o.icon = img
+	}
+}
+
+// Presentation option sets the Presenter used to put the pixels of the
+// window on the screen, such as NewTexturePresenter(). The default
+// is NewDrawPixelsPresenter().
+func Presentation(p Presenter) Option {
+	return func(o *options) {
+		o.presenter = p
+	}
+}
+
+// FrameRate option limits the number of times per second that the window is
+// presented, draws in between are batched into a single frame. The default
+// frame rate of zero only batches draws that happen in close succession.
+func FrameRate(rate int) Option {
+	return func(o *options) {
+		o.frameRate = rate
+	}
+}
+
+// Immediate option makes the window present after every draw, without
+// batching draws into frames.
+func Immediate(immediate bool) Option {
+	return func(o *options) {
+		o.immediate = immediate
+	}
+}
+
+// VSync option controls if presenting waits for the vertical blank of the
+// monitor, it is enabled by default and used by presenters that swap buffers.
+func VSync(vsync bool) Option {
+	return func(o *options) {
+		o.vsync = vsync
+	}
+}
+
+// FrameEvents option makes the window send an EventFrame after each time it
+// has been presented.
+func FrameEvents(enabled bool) Option {
+	return func(o *options) {
+		o.frameEvents = enabled
+	}
+}
+
+// CaptureFrames option makes the window write each presented frame to fw,
+// such as a GIFWriter or a PNGWriter. Errors are reported by fw, which
+// should be closed after the events channel of the window has been closed.
+func CaptureFrames(fw FrameWriter) Option {
+	return func(o *options) {
+		o.frames = fw
+	}
+}
+
+// EventQueue option configures the queue of events waiting to be received,
+// in order to bound its capacity and coalesce high-rate events.
+//
+// The QueueBlock policy is not supported by windows, since it would make
+// the event handling wait for the consumer of the events.
+func EventQueue(q QueueConfig) Option {
+	return func(o *options) {
+		o.queue = q
+	}
+}
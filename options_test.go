@@ -1,14 +1,41 @@
 package gui
 
-import "testing"
+import (
+	"image"
+	"testing"
+)
 
 func TestNewOptions(t *testing.T) {
 	want := options{
-		title:     "test-title",
-		width:     100,
-		height:    200,
-		resizable: true,
-		decorated: true,
+		title:      "test-title",
+		width:      100,
+		height:     200,
+		resizable:  true,
+		decorated:  true,
+		updateRate: 60,
+		sidedKeys:  true,
+
+		minWidth:    10,
+		minHeight:   20,
+		maxWidth:    1000,
+		maxHeight:   2000,
+		aspectNumer: 16,
+		aspectDenom: 9,
+		centered:    true,
+		floating:    true,
+		transparent: true,
+		visible:     false,
+		icon:        image.NewRGBA(image.Rect(0, 0, 16, 16)),
+
+		presenter: NewNopPresenter(),
+
+		frameRate:   30,
+		immediate:   true,
+		vsync:       false,
+		frameEvents: true,
+		frames:      NewPNGWriter("frame-%04d.png"),
+
+		queue: QueueConfig{Capacity: 100, Policy: QueueDropOldest, Coalesce: true},
 	}
 
 	got := newOptions(
@@ -16,6 +43,23 @@ func TestNewOptions(t *testing.T) {
 		Size(want.width, want.height),
 		Resizable(want.resizable),
 		Decorated(want.decorated),
+		UpdateRate(want.updateRate),
+		SidedModifierKeys(want.sidedKeys),
+		MinSize(want.minWidth, want.minHeight),
+		MaxSize(want.maxWidth, want.maxHeight),
+		AspectRatio(want.aspectNumer, want.aspectDenom),
+		Centered(),
+		Floating(want.floating),
+		Transparent(want.transparent),
+		Visible(want.visible),
+		Icon(want.icon),
+		Presentation(want.presenter),
+		FrameRate(want.frameRate),
+		Immediate(want.immediate),
+		VSync(want.vsync),
+		FrameEvents(want.frameEvents),
+		CaptureFrames(want.frames),
+		EventQueue(want.queue),
 	)
 
 	if got != want {
@@ -69,4 +113,190 @@ func TestOptions(t *testing.T) {
 			t.Fatalf("o.decorated = %v, want %v", got, want)
 		}
 	})
+
+	t.Run("UpdateRate", func(t *testing.T) {
+		rate := 60
+
+		UpdateRate(rate)(o)
+
+		if got, want := o.updateRate, rate; got != want {
+			t.Fatalf("o.updateRate = %d, want %d", got, want)
+		}
+	})
+
+	t.Run("SidedModifierKeys", func(t *testing.T) {
+		sided := true
+
+		SidedModifierKeys(sided)(o)
+
+		if got, want := o.sidedKeys, sided; got != want {
+			t.Fatalf("o.sidedKeys = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("MinSize", func(t *testing.T) {
+		width, height := 10, 20
+
+		MinSize(width, height)(o)
+
+		if got, want := o.minWidth, width; got != want {
+			t.Fatalf("o.minWidth = %d, want %d", got, want)
+		}
+
+		if got, want := o.minHeight, height; got != want {
+			t.Fatalf("o.minHeight = %d, want %d", got, want)
+		}
+	})
+
+	t.Run("MaxSize", func(t *testing.T) {
+		width, height := 1000, 2000
+
+		MaxSize(width, height)(o)
+
+		if got, want := o.maxWidth, width; got != want {
+			t.Fatalf("o.maxWidth = %d, want %d", got, want)
+		}
+
+		if got, want := o.maxHeight, height; got != want {
+			t.Fatalf("o.maxHeight = %d, want %d", got, want)
+		}
+	})
+
+	t.Run("AspectRatio", func(t *testing.T) {
+		numer, denom := 16, 9
+
+		AspectRatio(numer, denom)(o)
+
+		if got, want := o.aspectNumer, numer; got != want {
+			t.Fatalf("o.aspectNumer = %d, want %d", got, want)
+		}
+
+		if got, want := o.aspectDenom, denom; got != want {
+			t.Fatalf("o.aspectDenom = %d, want %d", got, want)
+		}
+	})
+
+	t.Run("Position", func(t *testing.T) {
+		x, y := 100, 200
+
+		Position(x, y)(o)
+
+		if got, want := image.Pt(o.x, o.y), image.Pt(x, y); got != want {
+			t.Fatalf("o.x, o.y = %v, want %v", got, want)
+		}
+
+		if !o.positioned || o.centered {
+			t.Fatalf("o.positioned = %v, o.centered = %v", o.positioned, o.centered)
+		}
+	})
+
+	t.Run("Centered", func(t *testing.T) {
+		Centered()(o)
+
+		if o.positioned || !o.centered {
+			t.Fatalf("o.positioned = %v, o.centered = %v", o.positioned, o.centered)
+		}
+	})
+
+	t.Run("Floating", func(t *testing.T) {
+		floating := true
+
+		Floating(floating)(o)
+
+		if got, want := o.floating, floating; got != want {
+			t.Fatalf("o.floating = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("Transparent", func(t *testing.T) {
+		transparent := true
+
+		Transparent(transparent)(o)
+
+		if got, want := o.transparent, transparent; got != want {
+			t.Fatalf("o.transparent = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("Visible", func(t *testing.T) {
+		visible := true
+
+		Visible(visible)(o)
+
+		if got, want := o.visible, visible; got != want {
+			t.Fatalf("o.visible = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("Icon", func(t *testing.T) {
+		icon := image.NewRGBA(image.Rect(0, 0, 16, 16))
+
+		Icon(icon)(o)
+
+		if got, want := o.icon, image.Image(icon); got != want {
+			t.Fatalf("o.icon = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("Presentation", func(t *testing.T) {
+		p := NewTexturePresenter()
+
+		Presentation(p)(o)
+
+		if got, want := o.presenter, p; got != want {
+			t.Fatalf("o.presenter = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("FrameRate", func(t *testing.T) {
+		FrameRate(30)(o)
+
+		if got, want := o.frameRate, 30; got != want {
+			t.Fatalf("o.frameRate = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("Immediate", func(t *testing.T) {
+		Immediate(true)(o)
+
+		if got, want := o.immediate, true; got != want {
+			t.Fatalf("o.immediate = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("VSync", func(t *testing.T) {
+		VSync(false)(o)
+
+		if got, want := o.vsync, false; got != want {
+			t.Fatalf("o.vsync = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("FrameEvents", func(t *testing.T) {
+		FrameEvents(true)(o)
+
+		if got, want := o.frameEvents, true; got != want {
+			t.Fatalf("o.frameEvents = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("CaptureFrames", func(t *testing.T) {
+		fw := NewGIFWriter(nil)
+
+		CaptureFrames(fw)(o)
+
+		if got, want := o.frames, FrameWriter(fw); got != want {
+			t.Fatalf("o.frames = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("EventQueue", func(t *testing.T) {
+		q := QueueConfig{Capacity: 10, Policy: QueueDropNewest}
+
+		EventQueue(q)(o)
+
+		if got, want := o.queue, q; got != want {
+			t.Fatalf("o.queue = %v, want %v", got, want)
+		}
+	})
 }
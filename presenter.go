@@ -0,0 +1,202 @@
+package gui
+
+import (
+	"image"
+	"image/draw"
+	"unsafe"
+
+	"github.com/go-gl/gl/v2.1/gl"
+)
+
+// Presenter puts the pixels of the image of a Window on the screen.
+//
+// The methods of a Presenter are called from a single goroutine that is
+// locked to its OS thread, Init before the first call to Present.
+type Presenter interface {
+	// Init prepares the presenter for presenting to the surface.
+	Init(s Surface)
+
+	// Present puts the pixels of img within the damaged
+	// rectangle r on the screen, r is never empty.
+	Present(img *image.RGBA, r image.Rectangle)
+}
+
+// UploadPresenter is implemented by presenters that upload pixels,
+// in order for the uploaded bytes to be counted by the Stats of a Window.
+type UploadPresenter interface {
+	Presenter
+
+	// Uploaded returns the number of bytes of pixels
+	// uploaded by the most recent call to Present.
+	Uploaded() int
+}
+
+// ContextPresenter is implemented by presenters that need
+// another kind of context than the default ContextOpenGL.
+type ContextPresenter interface {
+	Presenter
+
+	// Context returns the kind of context to create for the window.
+	Context() Context
+}
+
+// Context is the kind of rendering context that a Window creates
+// for its Presenter, before Init is called.
+type Context int
+
+// Contexts
+const (
+	// ContextOpenGL is an OpenGL context, which
+	// may use the compatibility profile.
+	ContextOpenGL Context = iota
+
+	// ContextOpenGLCore is an OpenGL 3.2 context with the core profile.
+	ContextOpenGLCore
+
+	// ContextNone is no context at all, for presenters that
+	// present to the native window without using OpenGL.
+	ContextNone
+)
+
+// contextOf returns the kind of context needed by p.
+func contextOf(p Presenter) Context {
+	if p, ok := p.(ContextPresenter); ok {
+		return p.Context()
+	}
+
+	return ContextOpenGL
+}
+
+// Surface is what a Presenter presents to, such as the OpenGL
+// context and framebuffer, or the native window, of a Window.
+type Surface interface {
+	// MakeContextCurrent makes the OpenGL context of the surface
+	// current on the calling thread, if there is a context.
+	MakeContextCurrent()
+
+	// SwapBuffers swaps the front and back buffers,
+	// if there is an OpenGL context.
+	SwapBuffers()
+
+	// FramebufferSize returns the size of the framebuffer in pixels.
+	FramebufferSize() (width, height int)
+
+	// VSync reports if swapping buffers should wait for the vertical blank.
+	VSync() bool
+
+	// NativeWindow returns the native handle of the window, such as an
+	// X11 Window, a Wayland wl_surface, a Win32 HWND or a Cocoa NSWindow.
+	NativeWindow() uintptr
+
+	// NativeDisplay returns the native handle of the display connection,
+	// such as an X11 Display or a Wayland wl_display, or zero on platforms
+	// that do not have one.
+	NativeDisplay() uintptr
+}
+
+// NewDrawPixelsPresenter returns a Presenter that draws the damaged
+// pixels directly to the front buffer using OpenGL, this is the default.
+func NewDrawPixelsPresenter() Presenter {
+	return &drawPixelsPresenter{}
+}
+
+// NewTexturePresenter returns a Presenter that uploads the damaged pixels
+// to an OpenGL texture, drawn to the back buffer and swapped, with vsync
+// unless it is disabled using the VSync option. It uses an OpenGL 3.2
+// context with the core profile.
+func NewTexturePresenter() Presenter {
+	return &texturePresenter{}
+}
+
+// NewNopPresenter returns a Presenter that does not present anything.
+func NewNopPresenter() Presenter {
+	return nopPresenter{}
+}
+
+type drawPixelsPresenter struct {
+	uploaded int
+}
+
+func (dp *drawPixelsPresenter) Init(s Surface) {
+	s.MakeContextCurrent()
+	gl.Init()
+}
+
+func (dp *drawPixelsPresenter) Present(img *image.RGBA, r image.Rectangle) {
+	bounds := img.Bounds()
+
+	tmp := image.NewRGBA(r)
+
+	draw.Draw(tmp, r, img, r.Min, draw.Src)
+
+	gl.DrawBuffer(gl.FRONT)
+	gl.Viewport(
+		int32(bounds.Min.X),
+		int32(bounds.Min.Y),
+		int32(bounds.Dx()),
+		int32(bounds.Dy()),
+	)
+	gl.RasterPos2d(
+		-1+2*float64(r.Min.X)/float64(bounds.Dx()),
+		+1-2*float64(r.Min.Y)/float64(bounds.Dy()),
+	)
+	gl.PixelZoom(1, -1)
+	gl.DrawPixels(
+		int32(r.Dx()),
+		int32(r.Dy()),
+		gl.RGBA,
+		gl.UNSIGNED_BYTE,
+		unsafe.Pointer(&tmp.Pix[0]),
+	)
+	gl.Flush()
+
+	dp.uploaded = len(tmp.Pix)
+}
+
+func (dp *drawPixelsPresenter) Uploaded() int {
+	return dp.uploaded
+}
+
+type nopPresenter struct{}
+
+func (np nopPresenter) Init(s Surface) {}
+
+func (np nopPresenter) Present(img *image.RGBA, r image.Rectangle) {}
+
+// windowSurface is the Surface of a Window, its methods
+// must only be called from the present thread.
+type windowSurface struct {
+	w *Window
+}
+
+func (ws windowSurface) MakeContextCurrent() {
+	if ws.w.context != ContextNone {
+		ws.w.w.MakeContextCurrent()
+	}
+}
+
+func (ws windowSurface) SwapBuffers() {
+	if ws.w.context != ContextNone {
+		ws.w.w.SwapBuffers()
+	}
+}
+
+// FramebufferSize returns the size of the image of the window, which
+// follows the size of the framebuffer as the window is resized.
+func (ws windowSurface) FramebufferSize() (int, int) {
+	size := ws.w.img.Bounds().Size()
+
+	return size.X, size.Y
+}
+
+func (ws windowSurface) VSync() bool {
+	return ws.w.vsync
+}
+
+func (ws windowSurface) NativeWindow() uintptr {
+	return nativeWindow(ws.w.w)
+}
+
+func (ws windowSurface) NativeDisplay() uintptr {
+	return nativeDisplay()
+}
@@ -0,0 +1,136 @@
+package gui
+
+import (
+	"image"
+	"testing"
+)
+
+func TestPresenters(t *testing.T) {
+	for _, p := range []Presenter{
+		NewDrawPixelsPresenter(),
+		NewTexturePresenter(),
+		NewNopPresenter(),
+	} {
+		if p == nil {
+			t.Fatalf("expected Presenter, got nil")
+		}
+	}
+}
+
+func TestWindowFlush(t *testing.T) {
+	p := &testPresenter{}
+
+	w := newWindow(QueueConfig{})
+	w.img = image.NewRGBA(image.Rect(0, 0, 10, 10))
+	w.presenter = p
+
+	w.flush(image.Rect(5, 5, 20, 20))
+	w.flush(image.Rect(20, 20, 30, 30))
+
+	if got, want := len(p.damage), 1; got != want {
+		t.Fatalf("len(p.damage) = %d, want %d", got, want)
+	}
+
+	if got, want := p.damage[0], image.Rect(5, 5, 10, 10); got != want {
+		t.Fatalf("p.damage[0] = %v, want %v", got, want)
+	}
+
+	s := w.Stats()
+
+	if got, want := s.Flushes, 1; got != want {
+		t.Fatalf("s.Flushes = %d, want %d", got, want)
+	}
+
+	if got, want := s.DamagedArea, int64(25); got != want {
+		t.Fatalf("s.DamagedArea = %d, want %d", got, want)
+	}
+
+	if got, want := s.FullArea, int64(100); got != want {
+		t.Fatalf("s.FullArea = %d, want %d", got, want)
+	}
+
+	if got, want := s.BytesUploaded, int64(100); got != want {
+		t.Fatalf("s.BytesUploaded = %d, want %d", got, want)
+	}
+}
+
+func TestWindowFlushNop(t *testing.T) {
+	w := newWindow(QueueConfig{})
+	w.img = image.NewRGBA(image.Rect(0, 0, 10, 10))
+	w.presenter = NewNopPresenter()
+
+	w.flush(w.img.Bounds())
+
+	if got, want := w.Stats().BytesUploaded, int64(0); got != want {
+		t.Fatalf("w.Stats().BytesUploaded = %d, want %d", got, want)
+	}
+}
+
+func TestWindowSurface(t *testing.T) {
+	w := newWindow(QueueConfig{})
+	w.img = image.NewRGBA(image.Rect(0, 0, 30, 20))
+	w.vsync = true
+
+	var s Surface = windowSurface{w}
+
+	if width, height := s.FramebufferSize(); width != 30 || height != 20 {
+		t.Fatalf("s.FramebufferSize() = %d, %d, want 30, 20", width, height)
+	}
+
+	if got, want := s.VSync(), true; got != want {
+		t.Fatalf("s.VSync() = %v, want %v", got, want)
+	}
+}
+
+func TestContextOf(t *testing.T) {
+	for _, tc := range []struct {
+		p    Presenter
+		want Context
+	}{
+		{NewDrawPixelsPresenter(), ContextOpenGL},
+		{NewTexturePresenter(), ContextOpenGLCore},
+		{NewNopPresenter(), ContextOpenGL},
+		{&testContextPresenter{context: ContextNone}, ContextNone},
+	} {
+		if got := contextOf(tc.p); got != tc.want {
+			t.Fatalf("contextOf(%T) = %v, want %v", tc.p, got, tc.want)
+		}
+	}
+}
+
+func TestWindowSurfaceContextNone(t *testing.T) {
+	w := newWindow(QueueConfig{})
+	w.context = ContextNone
+
+	var s Surface = windowSurface{w}
+
+	// There is no GLFW window, so these would panic if they used it
+	s.MakeContextCurrent()
+	s.SwapBuffers()
+}
+
+type testPresenter struct {
+	damage []image.Rectangle
+}
+
+func (tp *testPresenter) Init(s Surface) {}
+
+func (tp *testPresenter) Present(img *image.RGBA, r image.Rectangle) {
+	tp.damage = append(tp.damage, r)
+}
+
+func (tp *testPresenter) Uploaded() int {
+	r := tp.damage[len(tp.damage)-1]
+
+	return 4 * r.Dx() * r.Dy()
+}
+
+type testContextPresenter struct {
+	testPresenter
+
+	context Context
+}
+
+func (tp *testContextPresenter) Context() Context {
+	return tp.context
+}
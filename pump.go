@@ -0,0 +1,97 @@
+package gui
+
+import (
+	"sync"
+
+	"github.com/faiface/mainthread"
+	"github.com/go-gl/glfw/v3.3/glfw"
+)
+
+// pump is the event pump shared by all open windows.
+//
+// It waits for events in separate calls on the main thread, which leaves
+// the main thread available to other calls in between, such as opening
+// another window. The pump stops when the last window has been destroyed.
+var pump struct {
+	sync.Mutex
+	windows map[*Window]struct{}
+	running bool
+}
+
+// glfwInitialized is only used on the main thread.
+var glfwInitialized bool
+
+func initGLFW() error {
+	if glfwInitialized {
+		return nil
+	}
+
+	if err := glfw.Init(); err != nil {
+		return err
+	}
+
+	glfw.SetMonitorCallback(monitorCallback)
+
+	glfwInitialized = true
+
+	return nil
+}
+
+// addWindow adds the window to the event pump, starting the pump if needed.
+func addWindow(w *Window) {
+	pump.Lock()
+	defer pump.Unlock()
+
+	if pump.windows == nil {
+		pump.windows = map[*Window]struct{}{}
+	}
+
+	pump.windows[w] = struct{}{}
+
+	if !pump.running {
+		pump.running = true
+
+		go pumpEvents()
+	}
+}
+
+// removeWindow removes the window from the event pump.
+func removeWindow(w *Window) {
+	pump.Lock()
+	defer pump.Unlock()
+
+	delete(pump.windows, w)
+}
+
+func pumpEvents() {
+	for {
+		mainthread.Call(waitEvents)
+
+		pump.Lock()
+
+		if len(pump.windows) == 0 {
+			pump.running = false
+			pump.Unlock()
+			return
+		}
+
+		pump.Unlock()
+	}
+}
+
+func waitEvents() {
+	glfw.WaitEventsTimeout(1.0 / 30)
+}
+
+// call fn on the main thread, waking up the event pump if it is waiting for events.
+func call(fn func()) {
+	pump.Lock()
+	running := pump.running
+	pump.Unlock()
+
+	if running {
+		glfw.PostEmptyEvent()
+	}
+
+	mainthread.Call(fn)
+}
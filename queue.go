@@ -0,0 +1,163 @@
+package gui
+
+import (
+	"image"
+	"sync/atomic"
+)
+
+// QueuePolicy decides what happens when an event is
+// sent to a bounded event queue that is full.
+type QueuePolicy int
+
+// Queue policies
+const (
+	// QueueDropOldest drops the oldest event in the queue, it is the default.
+	QueueDropOldest QueuePolicy = iota
+
+	// QueueDropNewest drops the event being sent.
+	QueueDropNewest
+
+	// QueueBlock makes the sender wait until there is room in the queue.
+	// It is not supported by windows, which use QueueDropOldest instead.
+	QueueBlock
+)
+
+// QueueConfig configures the queue of events waiting to be received from the
+// events channel of an Env, which by default is unbounded.
+type QueueConfig struct {
+	// Capacity is the maximum number of queued events, zero means unbounded.
+	Capacity int
+
+	// Policy is used when the queue is full.
+	Policy QueuePolicy
+
+	// Coalesce makes an EventMouseMove or EventResize replace an event
+	// of the same type at the end of the queue, and an EventMouseScroll
+	// be added to an EventMouseScroll at the end of the queue.
+	Coalesce bool
+}
+
+// eventQueuer is implemented by the Envs that have a configured event queue.
+type eventQueuer interface {
+	eventQueue() QueueConfig
+}
+
+// eventQueueOf returns the QueueConfig of env, in order for the Envs wrapping
+// it to configure their queues the same way, by default it is unbounded.
+func eventQueueOf(env Env) QueueConfig {
+	if env, ok := env.(eventQueuer); ok {
+		return env.eventQueue()
+	}
+
+	return QueueConfig{}
+}
+
+// makeCountedEventsChan is like makeEventsChan, but the queue is configured
+// by q, and it stores the number of queued events in depth and the number
+// of dropped events in dropped, unless they are nil.
+func makeCountedEventsChan(q QueueConfig, depth, dropped *int64) (<-chan Event, chan<- Event) {
+	out, in := make(chan Event), make(chan Event)
+
+	count := func(queue []Event) {
+		if depth != nil {
+			atomic.StoreInt64(depth, int64(len(queue)))
+		}
+	}
+
+	go func() {
+		var queue []Event
+
+		for {
+			var (
+				recv  = in
+				send  chan<- Event
+				first Event
+			)
+
+			if len(queue) > 0 {
+				send, first = out, queue[0]
+			}
+
+			// Stop receiving until there is room in the queue
+			if q.full(queue) && q.Policy == QueueBlock {
+				recv = nil
+			}
+
+			select {
+			case send <- first:
+				queue = queue[1:]
+			case x, ok := <-recv:
+				if !ok {
+					for len(queue) > 0 {
+						out <- queue[0]
+						queue = queue[1:]
+						count(queue)
+					}
+					close(out)
+					return
+				}
+				queue = q.enqueue(queue, x, dropped)
+			}
+
+			count(queue)
+		}
+	}()
+
+	return out, in
+}
+
+func (q QueueConfig) full(queue []Event) bool {
+	return q.Capacity > 0 && len(queue) >= q.Capacity
+}
+
+// enqueue appends the event to the queue, coalescing it with the event at the
+// end of the queue if possible, or dropping an event if the queue is full.
+func (q QueueConfig) enqueue(queue []Event, x Event, dropped *int64) []Event {
+	if n := len(queue); n > 0 {
+		if e, ok := coalesce(queue[n-1], x, q.Coalesce); ok {
+			queue[n-1] = e
+			return queue
+		}
+	}
+
+	if q.full(queue) {
+		if dropped != nil {
+			atomic.AddInt64(dropped, 1)
+		}
+
+		switch q.Policy {
+		case QueueDropOldest:
+			queue = queue[1:]
+		case QueueDropNewest:
+			return queue
+		}
+	}
+
+	return append(queue, x)
+}
+
+// coalesce returns the event replacing last and x. An EventUpdate always
+// replaces an EventUpdate, since there is no point in delivering more than
+// one update to a slow consumer, other events only if all is true.
+func coalesce(last, x Event, all bool) (Event, bool) {
+	switch x := x.(type) {
+	case EventUpdate:
+		if _, ok := last.(EventUpdate); ok {
+			return x, true
+		}
+	case EventMouseMove:
+		if _, ok := last.(EventMouseMove); ok && all {
+			return x, true
+		}
+	case EventResize:
+		if _, ok := last.(EventResize); ok && all {
+			return x, true
+		}
+	case EventMouseScroll:
+		if l, ok := last.(EventMouseScroll); ok && all {
+			return EventMouseScroll{image.Point{l.X + x.X, l.Y + x.Y}}, true
+		}
+	}
+
+	return nil, false
+}
@@ -0,0 +1,159 @@
+package gui
+
+import (
+	"image"
+	"testing"
+	"time"
+)
+
+func TestQueueConfigEnqueue(t *testing.T) {
+	t.Run("Coalesce", func(t *testing.T) {
+		q := QueueConfig{Coalesce: true}
+
+		var queue []Event
+
+		for _, e := range []Event{
+			EventMouseMove{image.Pt(1, 1)},
+			EventMouseMove{image.Pt(2, 2)},
+			EventMouseScroll{image.Pt(0, 1)},
+			EventMouseScroll{image.Pt(1, 2)},
+			EventResize{image.Rect(0, 0, 1, 1)},
+			EventResize{image.Rect(0, 0, 2, 2)},
+			EventMouseMove{image.Pt(3, 3)},
+		} {
+			queue = q.enqueue(queue, e, nil)
+		}
+
+		want := []Event{
+			EventMouseMove{image.Pt(2, 2)},
+			EventMouseScroll{image.Pt(1, 3)},
+			EventResize{image.Rect(0, 0, 2, 2)},
+			EventMouseMove{image.Pt(3, 3)},
+		}
+
+		if got, want := len(queue), len(want); got != want {
+			t.Fatalf("len(queue) = %d, want %d", got, want)
+		}
+
+		for i := range want {
+			if got, want := queue[i], want[i]; got != want {
+				t.Fatalf("queue[%d] = %v, want %v", i, got, want)
+			}
+		}
+	})
+
+	t.Run("NoCoalesce", func(t *testing.T) {
+		var queue []Event
+
+		queue = QueueConfig{}.enqueue(queue, EventMouseMove{}, nil)
+		queue = QueueConfig{}.enqueue(queue, EventMouseMove{}, nil)
+
+		if got, want := len(queue), 2; got != want {
+			t.Fatalf("len(queue) = %d, want %d", got, want)
+		}
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		var queue []Event
+
+		first, second := EventUpdate{time.Unix(1, 0)}, EventUpdate{time.Unix(2, 0)}
+
+		queue = QueueConfig{}.enqueue(queue, EventClose{}, nil)
+		queue = QueueConfig{}.enqueue(queue, first, nil)
+		queue = QueueConfig{}.enqueue(queue, second, nil)
+
+		if got, want := len(queue), 2; got != want {
+			t.Fatalf("len(queue) = %d, want %d", got, want)
+		}
+
+		if got, want := queue[1], Event(second); got != want {
+			t.Fatalf("queue[1] = %v, want %v", got, want)
+		}
+
+		queue = QueueConfig{}.enqueue(queue, EventClose{}, nil)
+		queue = QueueConfig{}.enqueue(queue, first, nil)
+
+		if got, want := len(queue), 4; got != want {
+			t.Fatalf("len(queue) = %d, want %d", got, want)
+		}
+	})
+
+	for _, tt := range []struct {
+		policy QueuePolicy
+		first  Event
+	}{
+		{QueueDropOldest, EventKeyboardChar{'b'}},
+		{QueueDropNewest, EventKeyboardChar{'a'}},
+	} {
+		q := QueueConfig{Capacity: 2, Policy: tt.policy}
+
+		var (
+			queue   []Event
+			dropped int64
+		)
+
+		for _, r := range "abc" {
+			queue = q.enqueue(queue, EventKeyboardChar{r}, &dropped)
+		}
+
+		if got, want := len(queue), 2; got != want {
+			t.Fatalf("len(queue) = %d, want %d", got, want)
+		}
+
+		if got, want := queue[0], tt.first; got != want {
+			t.Fatalf("queue[0] = %v, want %v", got, want)
+		}
+
+		if got, want := dropped, int64(1); got != want {
+			t.Fatalf("dropped = %d, want %d", got, want)
+		}
+	}
+}
+
+func TestQueueBlock(t *testing.T) {
+	out, in := makeCountedEventsChan(QueueConfig{Capacity: 1, Policy: QueueBlock}, nil, nil)
+
+	in <- EventKeyboardChar{'a'}
+
+	sent := make(chan struct{})
+
+	go func() {
+		in <- EventKeyboardChar{'b'}
+		in <- EventKeyboardChar{'c'}
+		close(sent)
+	}()
+
+	select {
+	case <-sent:
+		t.Fatalf("expected sender to block on a full queue")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	for _, want := range "abc" {
+		if got := (<-out).(EventKeyboardChar).Char; got != want {
+			t.Fatalf("Char = %q, want %q", got, want)
+		}
+	}
+
+	<-sent
+}
+
+func TestHeadlessDroppedEvents(t *testing.T) {
+	h := NewHeadless(EventQueue(QueueConfig{Capacity: 2, Policy: QueueDropOldest}))
+	defer h.Close()
+
+	for _, r := range "abcd" {
+		h.Send(EventKeyboardChar{r})
+	}
+
+	// The last event may still be on its way into the queue
+	deadline := time.Now().Add(time.Second)
+
+	for h.Stats().DroppedEvents < 3 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+
+	if got, want := h.Stats().DroppedEvents, 3; got != want {
+		t.Fatalf("h.Stats().DroppedEvents = %d, want %d", got, want)
+	}
+}
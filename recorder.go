@@ -0,0 +1,108 @@
+package gui
+
+import (
+	"encoding/json"
+	"image"
+	"image/draw"
+	"io"
+	"sync"
+	"time"
+)
+
+// Recorder is an Env that wraps another Env and writes a session log of
+// all events and the rectangles returned by draw calls.
+//
+// The session log contains one JSON record per line, with the time
+// elapsed since the Recorder was created, the name of the event (or
+// "draw" for draw calls) and its data.
+type Recorder struct {
+	env    Env
+	events <-chan Event
+	start  time.Time
+
+	mu  sync.Mutex
+	enc *json.Encoder
+	err error
+}
+
+type record struct {
+	Time time.Duration   `json:"t"`
+	Name string          `json:"name"`
+	Data json.RawMessage `json:"data,omitempty"`
+}
+
+// recordDraw is the name used for draw records in the session log.
+const recordDraw = "draw"
+
+// NewRecorder creates a new Recorder that writes the session log of env to w.
+// The event queue of the Recorder is configured like the queue of env.
+func NewRecorder(env Env, w io.Writer) *Recorder {
+	out, in := makeCountedEventsChan(eventQueueOf(env), nil, nil)
+
+	rec := &Recorder{
+		env:    env,
+		events: out,
+		start:  time.Now(),
+		enc:    json.NewEncoder(w),
+	}
+
+	go func() {
+		for e := range env.Events() {
+			rec.write(e.Name(), e)
+			in <- e
+		}
+
+		close(in)
+	}()
+
+	return rec
+}
+
+// Err returns the first error that occurred when writing the session log.
+func (rec *Recorder) Err() error {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+
+	return rec.err
+}
+
+// Events returns the events channel of the wrapped Env.
+func (rec *Recorder) Events() <-chan Event { return rec.events }
+
+func (rec *Recorder) eventQueue() QueueConfig { return eventQueueOf(rec.env) }
+
+// Draw to the wrapped Env using the provided function.
+func (rec *Recorder) Draw(fn func(draw.Image) image.Rectangle) {
+	rec.env.Draw(func(dst draw.Image) image.Rectangle {
+		r := fn(dst)
+
+		rec.write(recordDraw, r)
+
+		return r
+	})
+}
+
+// Close closes the wrapped Env.
+func (rec *Recorder) Close() {
+	rec.env.Close()
+}
+
+func (rec *Recorder) write(name string, v interface{}) {
+	data, err := json.Marshal(v)
+
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+
+	t := time.Since(rec.start)
+
+	if rec.err != nil {
+		return
+	}
+
+	if err != nil {
+		rec.err = err
+		return
+	}
+
+	rec.err = rec.enc.Encode(record{Time: t, Name: name, Data: data})
+}
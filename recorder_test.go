@@ -0,0 +1,79 @@
+package gui
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"image"
+	"image/draw"
+	"testing"
+)
+
+func TestRecorderIsEnv(t *testing.T) {
+	var _ Env = &Recorder{}
+}
+
+func TestRecorder(t *testing.T) {
+	var buf bytes.Buffer
+
+	h := NewHeadless(Size(10, 10))
+	rec := NewRecorder(h, &buf)
+
+	<-rec.Events()
+
+	h.Send(EventMouseMove{image.Pt(1, 2)})
+
+	<-rec.Events()
+
+	rec.Draw(func(dst draw.Image) image.Rectangle {
+		return image.Rect(0, 0, 3, 4)
+	})
+
+	rec.Close()
+
+	for range rec.Events() {
+	}
+
+	if err := rec.Err(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var records []record
+
+	s := bufio.NewScanner(&buf)
+
+	for s.Scan() {
+		var r record
+
+		if err := json.Unmarshal(s.Bytes(), &r); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		records = append(records, r)
+	}
+
+	for i, want := range []struct {
+		name string
+		data string
+	}{
+		{"resize", `{"Min":{"X":0,"Y":0},"Max":{"X":10,"Y":10}}`},
+		{"mouse/move", `{"X":1,"Y":2}`},
+		{"draw", `{"Min":{"X":0,"Y":0},"Max":{"X":3,"Y":4}}`},
+	} {
+		if i >= len(records) {
+			t.Fatalf("len(records) = %d, want %d", len(records), 3)
+		}
+
+		if got := records[i].Name; got != want.name {
+			t.Fatalf("records[%d].Name = %q, want %q", i, got, want.name)
+		}
+
+		if got := string(records[i].Data); got != want.data {
+			t.Fatalf("records[%d].Data = %s, want %s", i, got, want.data)
+		}
+
+		if i > 0 && records[i].Time < records[i-1].Time {
+			t.Fatalf("records[%d].Time = %v, before %v", i, records[i].Time, records[i-1].Time)
+		}
+	}
+}
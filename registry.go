@@ -0,0 +1,95 @@
+package gui
+
+import (
+	"sort"
+	"sync"
+)
+
+var (
+	eventTypesMu sync.RWMutex
+	eventTypes   = map[string]func() Event{
+		"update":               func() Event { return EventUpdate{} },
+		"frame":                func() Event { return EventFrame{} },
+		"resize":               func() Event { return EventResize{} },
+		"close":                func() Event { return EventClose{} },
+		"focus/gained":         func() Event { return EventFocusGained{} },
+		"focus/lost":           func() Event { return EventFocusLost{} },
+		"mouse/enter":          func() Event { return EventMouseEnter{} },
+		"mouse/leave":          func() Event { return EventMouseLeave{} },
+		"window/minimize":      func() Event { return EventMinimize{} },
+		"window/maximize":      func() Event { return EventMaximize{} },
+		"window/restore":       func() Event { return EventRestore{} },
+		"window/move":          func() Event { return EventMove{} },
+		"window/scale":         func() Event { return EventScale{} },
+		"monitor/connected":    func() Event { return EventMonitorConnected{} },
+		"monitor/disconnected": func() Event { return EventMonitorDisconnected{} },
+		"mouse/move":           func() Event { return EventMouseMove{} },
+		"mouse/relative":       func() Event { return EventMouseRelative{} },
+		"mouse/scroll":         func() Event { return EventMouseScroll{} },
+		"mouse/left/down":      func() Event { return EventMouseLeftDown{} },
+		"mouse/left/up":        func() Event { return EventMouseLeftUp{} },
+		"mouse/middle/down":    func() Event { return EventMouseMiddleDown{} },
+		"mouse/middle/up":      func() Event { return EventMouseMiddleUp{} },
+		"mouse/right/down":     func() Event { return EventMouseRightDown{} },
+		"mouse/right/up":       func() Event { return EventMouseRightUp{} },
+		"drop":                 func() Event { return EventDrop{} },
+		"keyboard/char":        func() Event { return EventKeyboardChar{} },
+		"keyboard/down":        func() Event { return EventKeyboardDown{} },
+		"keyboard/up":          func() Event { return EventKeyboardUp{} },
+		"keyboard/repeat":      func() Event { return EventKeyboardRepeat{} },
+	}
+)
+
+// RegisterEvent makes an event type available by the provided name, which
+// allows events of that type to be decoded by UnmarshalEvent and Replay.
+//
+// The factory returns the zero value of the event, the name must match
+// the name of the returned event. If RegisterEvent is called twice with
+// the same name or if factory is nil, it panics.
+func RegisterEvent(name string, factory func() Event) {
+	eventTypesMu.Lock()
+	defer eventTypesMu.Unlock()
+
+	if factory == nil {
+		panic("gui: RegisterEvent factory is nil")
+	}
+
+	if _, dup := eventTypes[name]; dup {
+		panic("gui: RegisterEvent called twice for event " + name)
+	}
+
+	if got := factory().Name(); got != name {
+		panic("gui: RegisterEvent factory returned event " + got + " for " + name)
+	}
+
+	eventTypes[name] = factory
+}
+
+// LookupEvent returns the zero value of the event registered with the name.
+func LookupEvent(name string) (Event, bool) {
+	eventTypesMu.RLock()
+	factory, ok := eventTypes[name]
+	eventTypesMu.RUnlock()
+
+	if !ok {
+		return nil, false
+	}
+
+	return factory(), true
+}
+
+// EventNames returns a sorted list of the names of all registered events.
+func EventNames() []string {
+	eventTypesMu.RLock()
+	defer eventTypesMu.RUnlock()
+
+	names := make([]string, 0, len(eventTypes))
+
+	for name := range eventTypes {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
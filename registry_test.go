@@ -0,0 +1,102 @@
+package gui
+
+import "testing"
+
+type testCustomEvent struct {
+	Value int
+}
+
+func (e testCustomEvent) Name() string {
+	return "test/custom"
+}
+
+func (e testCustomEvent) Data() interface{} {
+	return e.Value
+}
+
+func init() {
+	RegisterEvent("test/custom", func() Event { return testCustomEvent{} })
+}
+
+func TestRegisterEvent(t *testing.T) {
+	e, ok := LookupEvent("test/custom")
+	if !ok {
+		t.Fatalf("expected test/custom to be registered")
+	}
+
+	if got, want := e, (testCustomEvent{}); got != want {
+		t.Fatalf("LookupEvent(%q) = %#v, want %#v", "test/custom", got, want)
+	}
+
+	data, err := MarshalEvent(testCustomEvent{42})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got, err := UnmarshalEvent(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if want := (testCustomEvent{42}); got != want {
+		t.Fatalf("UnmarshalEvent(%s) = %#v, want %#v", data, got, want)
+	}
+
+	for _, tt := range []struct {
+		name    string
+		factory func() Event
+	}{
+		{"test/custom", func() Event { return testCustomEvent{} }},
+		{"test/nil", nil},
+		{"test/mismatch", func() Event { return testCustomEvent{} }},
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Fatalf("expected RegisterEvent(%q) to panic", tt.name)
+				}
+			}()
+
+			RegisterEvent(tt.name, tt.factory)
+		}()
+	}
+}
+
+func TestLookupEvent(t *testing.T) {
+	if _, ok := LookupEvent("unknown"); ok {
+		t.Fatalf("expected unknown to not be registered")
+	}
+
+	for _, name := range []string{"close", "mouse/move", "keyboard/down"} {
+		e, ok := LookupEvent(name)
+		if !ok {
+			t.Fatalf("expected %q to be registered", name)
+		}
+
+		if got := e.Name(); got != name {
+			t.Fatalf("e.Name() = %q, want %q", got, name)
+		}
+	}
+}
+
+func TestEventNamesFunc(t *testing.T) {
+	names := EventNames()
+
+	for i := 1; i < len(names); i++ {
+		if names[i-1] >= names[i] {
+			t.Fatalf("names are not sorted: %q >= %q", names[i-1], names[i])
+		}
+	}
+
+	registered := map[string]bool{}
+
+	for _, name := range names {
+		registered[name] = true
+	}
+
+	for _, e := range testEvents {
+		if !registered[e.Name()] {
+			t.Fatalf("expected %q to be in %v", e.Name(), names)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package gui
+
+import (
+	"bufio"
+	"encoding/json"
+	"image"
+	"io"
+	"time"
+)
+
+// Replay is an Env that feeds the events of a session log, as written by a
+// Recorder, back into an application. Draws are captured into an in-memory
+// image, in the same way as for the Headless Env.
+//
+// The events are never dropped, instead the Replay waits for the
+// application to receive them when its bounded event queue is full.
+type Replay struct {
+	*Headless
+
+	done chan struct{}
+}
+
+// replayQueueCapacity is the maximum number of queued events of a Replay.
+const replayQueueCapacity = 64
+
+type timedEvent struct {
+	time  time.Duration
+	event Event
+}
+
+// NewReplay reads a session log from r and creates a new Replay of it.
+//
+// The events are sent with their original timing if realtime is true,
+// otherwise as fast as they are received. The size of the image is taken
+// from the first EventResize in the session log, defaulting to 640x480.
+func NewReplay(r io.Reader, realtime bool) (*Replay, error) {
+	events, err := readSessionLog(r)
+	if err != nil {
+		return nil, err
+	}
+
+	bounds := image.Rect(0, 0, 640, 480)
+
+	for _, te := range events {
+		if e, ok := te.event.(EventResize); ok {
+			bounds = e.Rectangle
+			break
+		}
+	}
+
+	q := QueueConfig{Capacity: replayQueueCapacity, Policy: QueueBlock}
+
+	rp := &Replay{
+		Headless: newHeadless(bounds, q),
+		done:     make(chan struct{}),
+	}
+
+	rp.senders.Add(1)
+
+	go rp.play(events, realtime)
+
+	return rp, nil
+}
+
+// Done returns a channel that is closed when all events have been sent.
+func (rp *Replay) Done() <-chan struct{} {
+	return rp.done
+}
+
+func (rp *Replay) play(events []timedEvent, realtime bool) {
+	defer rp.senders.Done()
+	defer close(rp.done)
+
+	start := time.Now()
+
+	for _, te := range events {
+		if realtime {
+			select {
+			case <-time.After(time.Until(start.Add(te.time))):
+			case <-rp.finish:
+				return
+			}
+		}
+
+		if e, ok := te.event.(EventResize); ok {
+			select {
+			case rp.newSize <- e.Rectangle:
+			case <-rp.finish:
+				return
+			}
+		}
+
+		select {
+		case rp.in <- te.event:
+		case <-rp.finish:
+			return
+		}
+	}
+}
+
+func readSessionLog(r io.Reader) ([]timedEvent, error) {
+	var events []timedEvent
+
+	s := bufio.NewScanner(r)
+
+	for s.Scan() {
+		var rec record
+
+		if err := json.Unmarshal(s.Bytes(), &rec); err != nil {
+			return nil, err
+		}
+
+		if rec.Name == recordDraw {
+			continue
+		}
+
+		e, err := decodeEvent(rec.Name, rec.Data)
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, timedEvent{rec.Time, e})
+	}
+
+	return events, s.Err()
+}
@@ -0,0 +1,74 @@
+package gui
+
+import (
+	"image"
+	"strings"
+	"testing"
+)
+
+const testSessionLog = `{"t":0,"name":"resize","data":{"Min":{"X":0,"Y":0},"Max":{"X":20,"Y":10}}}
+{"t":1000,"name":"mouse/move","data":{"X":1,"Y":2}}
+{"t":2000,"name":"draw","data":{"Min":{"X":0,"Y":0},"Max":{"X":3,"Y":4}}}
+{"t":3000,"name":"keyboard/down","data":{"Key":"escape"}}
+{"t":4000,"name":"close","data":{}}
+`
+
+func TestReplayIsEnv(t *testing.T) {
+	var _ Env = &Replay{}
+}
+
+func TestNewReplay(t *testing.T) {
+	for _, realtime := range []bool{false, true} {
+		rp, err := NewReplay(strings.NewReader(testSessionLog), realtime)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		want := []Event{
+			EventResize{image.Rect(0, 0, 20, 10)},
+			EventMouseMove{image.Pt(1, 2)},
+			EventKeyboardDown{Key: "escape"},
+			EventClose{},
+		}
+
+		for i := range want {
+			if got := <-rp.Events(); got != want[i] {
+				t.Fatalf("event %d = %#v, want %#v", i, got, want[i])
+			}
+		}
+
+		<-rp.Done()
+
+		if got, want := rp.Image().Bounds(), image.Rect(0, 0, 20, 10); got != want {
+			t.Fatalf("rp.Image().Bounds() = %v, want %v", got, want)
+		}
+
+		rp.Close()
+	}
+}
+
+func TestNewReplayError(t *testing.T) {
+	for _, log := range []string{
+		`{"t":0,"name":"unknown"}`,
+		`{"t":0,"name":"resize","data":[]}`,
+		`not json`,
+	} {
+		if _, err := NewReplay(strings.NewReader(log), false); err == nil {
+			t.Fatalf("expected error for %q", log)
+		}
+	}
+}
+
+func TestReplayClose(t *testing.T) {
+	rp, err := NewReplay(strings.NewReader(testSessionLog), true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	rp.Close()
+
+	<-rp.Done()
+
+	for range rp.Events() {
+	}
+}
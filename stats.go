@@ -0,0 +1,161 @@
+package gui
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Stats is a snapshot of the performance counters of a Window or a Mux.
+type Stats struct {
+	// Draws and Flushes are the total number of draws and flushes,
+	// where a flush presents the damage of one or more draws.
+	Draws   int
+	Flushes int
+
+	// DrawsPerSecond and FlushesPerSecond
+	// are counted over the last whole second.
+	DrawsPerSecond   int
+	FlushesPerSecond int
+
+	// FlushLatency is the average time it takes to flush, and the
+	// percentiles are over the most recent flushes.
+	FlushLatency    time.Duration
+	FlushLatencyP50 time.Duration
+	FlushLatencyP90 time.Duration
+	FlushLatencyP99 time.Duration
+
+	// BytesUploaded is the total number of bytes of pixels uploaded by the
+	// Presenter, as reported by presenters implementing UploadPresenter.
+	BytesUploaded int64
+
+	// DamagedArea is the total number of pixels flushed, and FullArea is the
+	// total number of pixels that would have been flushed without damage tracking.
+	DamagedArea int64
+	FullArea    int64
+
+	// QueueDepth is the number of events waiting to be received, and
+	// DroppedEvents is the number of events dropped since the queue was full.
+	QueueDepth    int
+	DroppedEvents int
+}
+
+// latencySamples is the number of flush latencies kept for percentiles.
+const latencySamples = 256
+
+// stats collects performance counters, it is safe for concurrent use.
+type stats struct {
+	// queueDepth and dropped are first in the struct, in order to be
+	// 64-bit aligned for atomic operations on 32-bit platforms.
+	queueDepth int64
+	dropped    int64
+
+	mu sync.Mutex
+
+	draws, flushes       int
+	drawRate, flushRate  rate
+	totalLatency         time.Duration
+	latencies            []time.Duration
+	next                 int
+	bytes, damaged, full int64
+}
+
+func (s *stats) draw(now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.draws++
+	s.drawRate.add(now)
+}
+
+func (s *stats) flush(now time.Time, latency time.Duration, damaged, full, uploaded int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.flushes++
+	s.flushRate.add(now)
+	s.totalLatency += latency
+	s.bytes += int64(uploaded)
+	s.damaged += int64(damaged)
+	s.full += int64(full)
+
+	if len(s.latencies) < latencySamples {
+		s.latencies = append(s.latencies, latency)
+	} else {
+		s.latencies[s.next] = latency
+		s.next = (s.next + 1) % latencySamples
+	}
+}
+
+func (s *stats) snapshot(now time.Time) Stats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st := Stats{
+		Draws:            s.draws,
+		Flushes:          s.flushes,
+		DrawsPerSecond:   s.drawRate.get(now),
+		FlushesPerSecond: s.flushRate.get(now),
+		BytesUploaded:    s.bytes,
+		DamagedArea:      s.damaged,
+		FullArea:         s.full,
+		QueueDepth:       int(atomic.LoadInt64(&s.queueDepth)),
+		DroppedEvents:    int(atomic.LoadInt64(&s.dropped)),
+	}
+
+	if s.flushes > 0 {
+		st.FlushLatency = s.totalLatency / time.Duration(s.flushes)
+	}
+
+	if len(s.latencies) > 0 {
+		sorted := append([]time.Duration(nil), s.latencies...)
+
+		sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+		st.FlushLatencyP50 = percentile(sorted, 50)
+		st.FlushLatencyP90 = percentile(sorted, 90)
+		st.FlushLatencyP99 = percentile(sorted, 99)
+	}
+
+	return st
+}
+
+// percentile returns the p:th percentile of the sorted durations.
+func percentile(sorted []time.Duration, p int) time.Duration {
+	return sorted[(len(sorted)-1)*p/100]
+}
+
+// rate counts events per whole second.
+type rate struct {
+	sec       int64
+	cur, last int
+}
+
+func (r *rate) add(now time.Time) {
+	sec := now.Unix()
+
+	if sec != r.sec {
+		if sec == r.sec+1 {
+			r.last = r.cur
+		} else {
+			r.last = 0
+		}
+
+		r.sec, r.cur = sec, 0
+	}
+
+	r.cur++
+}
+
+// get returns the count of the last whole second.
+func (r *rate) get(now time.Time) int {
+	switch now.Unix() {
+	case r.sec:
+		return r.last
+	case r.sec + 1:
+		return r.cur
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,75 @@
+package gui
+
+import (
+	"testing"
+	"time"
+)
+
+func TestStats(t *testing.T) {
+	var s stats
+
+	now := time.Unix(100, 0)
+
+	s.draw(now)
+	s.draw(now)
+
+	for i := 1; i <= 100; i++ {
+		s.flush(now, time.Duration(i)*time.Millisecond, 10, 100, 40)
+	}
+
+	st := s.snapshot(now.Add(time.Second))
+
+	if got, want := st.Draws, 2; got != want {
+		t.Fatalf("st.Draws = %d, want %d", got, want)
+	}
+
+	if got, want := st.DrawsPerSecond, 2; got != want {
+		t.Fatalf("st.DrawsPerSecond = %d, want %d", got, want)
+	}
+
+	if got, want := st.FlushesPerSecond, 100; got != want {
+		t.Fatalf("st.FlushesPerSecond = %d, want %d", got, want)
+	}
+
+	if got, want := st.FlushLatency, 50500*time.Microsecond; got != want {
+		t.Fatalf("st.FlushLatency = %v, want %v", got, want)
+	}
+
+	if got, want := st.FlushLatencyP50, 50*time.Millisecond; got != want {
+		t.Fatalf("st.FlushLatencyP50 = %v, want %v", got, want)
+	}
+
+	if got, want := st.FlushLatencyP99, 99*time.Millisecond; got != want {
+		t.Fatalf("st.FlushLatencyP99 = %v, want %v", got, want)
+	}
+
+	if got, want := st.BytesUploaded, int64(4000); got != want {
+		t.Fatalf("st.BytesUploaded = %d, want %d", got, want)
+	}
+
+	if got, want := st.FullArea, int64(10000); got != want {
+		t.Fatalf("st.FullArea = %d, want %d", got, want)
+	}
+
+	if got := s.snapshot(now.Add(2 * time.Second)).DrawsPerSecond; got != 0 {
+		t.Fatalf("DrawsPerSecond = %d, want 0", got)
+	}
+}
+
+func TestRate(t *testing.T) {
+	var r rate
+
+	now := time.Unix(100, 0)
+
+	r.add(now)
+	r.add(now.Add(time.Second))
+	r.add(now.Add(time.Second))
+
+	if got, want := r.get(now.Add(time.Second)), 1; got != want {
+		t.Fatalf("r.get() = %d, want %d", got, want)
+	}
+
+	if got, want := r.get(now.Add(2*time.Second)), 2; got != want {
+		t.Fatalf("r.get() = %d, want %d", got, want)
+	}
+}
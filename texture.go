@@ -0,0 +1,234 @@
+package gui
+
+import (
+	"image"
+	"strings"
+
+	"github.com/go-gl/gl/v3.2-core/gl"
+	"github.com/go-gl/glfw/v3.3/glfw"
+)
+
+// textureVertexShader passes the corners of the quad through, with
+// the texture coordinates flipped since the image is stored top down.
+const textureVertexShader = `
+#version 150
+
+in vec2 position;
+out vec2 uv;
+
+void main() {
+	uv = vec2(position.x+1.0, 1.0-position.y) / 2.0;
+	gl_Position = vec4(position, 0.0, 1.0);
+}
+` + "\x00"
+
+const textureFragmentShader = `
+#version 150
+
+uniform sampler2D tex;
+in vec2 uv;
+out vec4 color;
+
+void main() {
+	color = texture(tex, uv);
+}
+` + "\x00"
+
+// textureQuad is the triangle strip covering the viewport.
+var textureQuad = []float32{
+	-1, 1,
+	1, 1,
+	-1, -1,
+	1, -1,
+}
+
+// texturePresenter presents an image by uploading the damaged parts of it
+// to a texture through a pixel buffer object, and then drawing the texture
+// as a quad to the back buffer, which is swapped with vsync.
+//
+// It only uses the core profile of OpenGL 3.2.
+type texturePresenter struct {
+	surface Surface
+	id      uint32
+	pbo     uint32
+	vao     uint32
+	vbo     uint32
+	program uint32
+	bounds  image.Rectangle
+
+	// uploaded is the number of bytes uploaded by the last
+	// call to Present, which is the whole image when resized.
+	uploaded int
+}
+
+func (t *texturePresenter) Context() Context {
+	return ContextOpenGLCore
+}
+
+func (t *texturePresenter) Uploaded() int {
+	return t.uploaded
+}
+
+func (t *texturePresenter) Init(s Surface) {
+	t.surface = s
+	t.surface.MakeContextCurrent()
+	gl.Init()
+
+	gl.GenTextures(1, &t.id)
+	gl.BindTexture(gl.TEXTURE_2D, t.id)
+	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MIN_FILTER, gl.NEAREST)
+	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MAG_FILTER, gl.NEAREST)
+	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_WRAP_S, gl.CLAMP_TO_EDGE)
+	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_WRAP_T, gl.CLAMP_TO_EDGE)
+
+	gl.GenBuffers(1, &t.pbo)
+
+	t.program = linkProgram(textureVertexShader, textureFragmentShader)
+
+	gl.UseProgram(t.program)
+	gl.Uniform1i(gl.GetUniformLocation(t.program, gl.Str("tex\x00")), 0)
+
+	gl.GenVertexArrays(1, &t.vao)
+	gl.BindVertexArray(t.vao)
+
+	gl.GenBuffers(1, &t.vbo)
+	gl.BindBuffer(gl.ARRAY_BUFFER, t.vbo)
+	gl.BufferData(gl.ARRAY_BUFFER, 4*len(textureQuad), gl.Ptr(textureQuad), gl.STATIC_DRAW)
+
+	position := uint32(gl.GetAttribLocation(t.program, gl.Str("position\x00")))
+
+	gl.EnableVertexAttribArray(position)
+	gl.VertexAttribPointer(position, 2, gl.FLOAT, false, 0, nil)
+
+	glfw.SwapInterval(glfwBool(s.VSync()))
+}
+
+func (t *texturePresenter) Present(img *image.RGBA, r image.Rectangle) {
+	bounds := img.Bounds()
+
+	gl.BindTexture(gl.TEXTURE_2D, t.id)
+
+	if bounds != t.bounds {
+		gl.TexImage2D(
+			gl.TEXTURE_2D, 0, gl.RGBA8,
+			int32(bounds.Dx()),
+			int32(bounds.Dy()),
+			0, gl.RGBA, gl.UNSIGNED_BYTE, nil,
+		)
+
+		t.bounds = bounds
+		r = bounds
+	}
+
+	t.upload(img, r)
+
+	gl.DrawBuffer(gl.BACK)
+	gl.Viewport(0, 0, int32(bounds.Dx()), int32(bounds.Dy()))
+
+	gl.UseProgram(t.program)
+	gl.BindVertexArray(t.vao)
+	gl.DrawArrays(gl.TRIANGLE_STRIP, 0, 4)
+
+	t.surface.SwapBuffers()
+}
+
+// upload copies the rectangle r of img into the pixel buffer
+// object, and from there into the same rectangle of the texture.
+func (t *texturePresenter) upload(img *image.RGBA, r image.Rectangle) {
+	n := 4 * r.Dx() * r.Dy()
+
+	t.uploaded = 0
+
+	gl.BindBuffer(gl.PIXEL_UNPACK_BUFFER, t.pbo)
+	defer gl.BindBuffer(gl.PIXEL_UNPACK_BUFFER, 0)
+
+	// Orphan the previous buffer, so that mapping
+	// does not wait for the previous upload
+	gl.BufferData(gl.PIXEL_UNPACK_BUFFER, n, nil, gl.STREAM_DRAW)
+
+	ptr := gl.MapBuffer(gl.PIXEL_UNPACK_BUFFER, gl.WRITE_ONLY)
+	if ptr == nil {
+		return
+	}
+
+	dst := (*[1 << 30]byte)(ptr)[:n:n]
+	stride := 4 * r.Dx()
+
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		i := img.PixOffset(r.Min.X, y)
+		copy(dst[(y-r.Min.Y)*stride:], img.Pix[i:i+stride])
+	}
+
+	if !gl.UnmapBuffer(gl.PIXEL_UNPACK_BUFFER) {
+		return
+	}
+
+	gl.TexSubImage2D(
+		gl.TEXTURE_2D, 0,
+		int32(r.Min.X-t.bounds.Min.X),
+		int32(r.Min.Y-t.bounds.Min.Y),
+		int32(r.Dx()),
+		int32(r.Dy()),
+		gl.RGBA, gl.UNSIGNED_BYTE, nil,
+	)
+
+	t.uploaded = n
+}
+
+// linkProgram compiles and links the shaders, the
+// errors are logged since they are programming errors.
+func linkProgram(vertexSource, fragmentSource string) uint32 {
+	program := gl.CreateProgram()
+
+	for _, s := range []struct {
+		kind   uint32
+		source string
+	}{
+		{gl.VERTEX_SHADER, vertexSource},
+		{gl.FRAGMENT_SHADER, fragmentSource},
+	} {
+		shader := gl.CreateShader(s.kind)
+
+		src, free := gl.Strs(s.source)
+		gl.ShaderSource(shader, 1, src, nil)
+		free()
+		gl.CompileShader(shader)
+
+		var status int32
+		if gl.GetShaderiv(shader, gl.COMPILE_STATUS, &status); status == gl.FALSE {
+			Log("gui: compiling shader: %s", shaderInfoLog(shader))
+		}
+
+		gl.AttachShader(program, shader)
+		gl.DeleteShader(shader)
+	}
+
+	gl.LinkProgram(program)
+
+	var status int32
+	if gl.GetProgramiv(program, gl.LINK_STATUS, &status); status == gl.FALSE {
+		Log("gui: linking program: %s", programInfoLog(program))
+	}
+
+	return program
+}
+
+func programInfoLog(program uint32) string {
+	var n int32
+	gl.GetProgramiv(program, gl.INFO_LOG_LENGTH, &n)
+
+	log := strings.Repeat("\x00", int(n+1))
+	gl.GetProgramInfoLog(program, n, nil, gl.Str(log))
+
+	return strings.TrimRight(log, "\x00")
+}
+
+func shaderInfoLog(shader uint32) string {
+	var n int32
+	gl.GetShaderiv(shader, gl.INFO_LOG_LENGTH, &n)
+
+	log := strings.Repeat("\x00", int(n+1))
+	gl.GetShaderInfoLog(shader, n, nil, gl.Str(log))
+
+	return strings.TrimRight(log, "\x00")
+}
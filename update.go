@@ -0,0 +1,44 @@
+package gui
+
+import "time"
+
+// sendUpdates sends EventUpdate to in the given number of times per second
+// until stop is closed.
+//
+// Updates are scheduled relative to the first one, so the cadence does not
+// drift, and updates that are missed (if in is not ready in time) are
+// coalesced into a single EventUpdate.
+func sendUpdates(rate int, in chan<- Event, stop <-chan struct{}) {
+	period := time.Second / time.Duration(rate)
+
+	// Rates above one billion would otherwise make the period zero
+	if period < 1 {
+		period = 1
+	}
+
+	next := time.Now().Add(period)
+
+	timer := time.NewTimer(period)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case now := <-timer.C:
+			select {
+			case in <- EventUpdate{now}:
+			case <-stop:
+				return
+			}
+
+			next = next.Add(period)
+
+			if behind := time.Since(next); behind > 0 {
+				next = next.Add((behind/period + 1) * period)
+			}
+
+			timer.Reset(time.Until(next))
+		}
+	}
+}
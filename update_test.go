@@ -0,0 +1,101 @@
+package gui
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSendUpdates(t *testing.T) {
+	out, in := makeEventsChan()
+	stop := make(chan struct{})
+	done := make(chan struct{})
+
+	go func() {
+		sendUpdates(100, in, stop)
+		close(done)
+	}()
+
+	var last time.Time
+
+	for i := 0; i < 3; i++ {
+		e, ok := (<-out).(EventUpdate)
+		if !ok {
+			t.Fatalf("expected EventUpdate")
+		}
+
+		if !e.Time.After(last) {
+			t.Fatalf("e.Time = %v, want after %v", e.Time, last)
+		}
+
+		last = e.Time
+	}
+
+	close(stop)
+	<-done
+}
+
+func TestSendUpdatesHighRate(t *testing.T) {
+	out, in := makeEventsChan()
+	stop := make(chan struct{})
+	done := make(chan struct{})
+
+	go func() {
+		sendUpdates(2000000000, in, stop)
+		close(done)
+	}()
+
+	for i := 0; i < 3; i++ {
+		if _, ok := (<-out).(EventUpdate); !ok {
+			t.Fatalf("expected EventUpdate")
+		}
+	}
+
+	close(stop)
+	<-done
+}
+
+func TestSendUpdatesCoalesced(t *testing.T) {
+	out, in := makeEventsChan()
+	stop := make(chan struct{})
+	done := make(chan struct{})
+
+	go func() {
+		sendUpdates(1000, in, stop)
+		close(done)
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+
+	close(stop)
+	<-done
+
+	in <- EventClose{}
+	close(in)
+
+	var n int
+
+	for e := range out {
+		if _, ok := e.(EventUpdate); ok {
+			n++
+		}
+	}
+
+	if n != 1 {
+		t.Fatalf("got %d EventUpdate, want 1", n)
+	}
+}
+
+func TestHeadlessUpdateRate(t *testing.T) {
+	h := NewHeadless(UpdateRate(100))
+
+	<-h.Events()
+
+	if _, ok := (<-h.Events()).(EventUpdate); !ok {
+		t.Fatalf("expected EventUpdate")
+	}
+
+	h.Close()
+
+	for range h.Events() {
+	}
+}
@@ -3,13 +3,13 @@ package gui
 import (
 	"image"
 	"image/draw"
+	"math"
 	"runtime"
+	"sync"
 	"time"
-	"unsafe"
 
 	"github.com/faiface/mainthread"
-	"github.com/go-gl/gl/v2.1/gl"
-	"github.com/go-gl/glfw/v3.2/glfw"
+	"github.com/go-gl/glfw/v3.3/glfw"
 )
 
 // Run calls mainthread.Run
@@ -17,30 +17,79 @@ func Run(fn func()) {
 	mainthread.Run(fn)
 }
 
-func newWindow() *Window {
-	out, in := makeEventsChan()
+func newWindow(q QueueConfig) *Window {
+	// Blocking would make the callbacks, and everything else
+	// waiting for the main thread, wait for the consumer of the events
+	if q.Policy == QueueBlock {
+		q.Policy = QueueDropOldest
+	}
 
-	return &Window{
-		out:     out,
-		in:      in,
-		draw:    make(chan func(draw.Image) image.Rectangle),
-		newSize: make(chan image.Rectangle),
-		finish:  make(chan struct{}),
+	w := &Window{
+		queue:    q,
+		draw:     make(chan func(draw.Image) image.Rectangle),
+		newSize:  make(chan image.Rectangle),
+		snapshot: make(chan chan *image.RGBA),
+		finish:   make(chan struct{}),
+		stats:    &stats{},
 	}
+
+	w.out, w.in = makeCountedEventsChan(q, &w.stats.queueDepth, &w.stats.dropped)
+
+	return w
 }
 
 // Window is an Env that handles an actual graphical window.
+//
+// The methods controlling the window, such as SetTitle, do nothing once
+// the window has been closed, and the ones returning an error return one.
 type Window struct {
-	out  <-chan Event
-	in   chan<- Event
-	draw chan func(draw.Image) image.Rectangle
+	out   <-chan Event
+	in    chan<- Event
+	queue QueueConfig
+	draw  chan func(draw.Image) image.Rectangle
+
+	newSize  chan image.Rectangle
+	snapshot chan chan *image.RGBA
+	finish   chan struct{}
+
+	// senders are goroutines sending to in, that
+	// need to stop before in can be closed.
+	senders sync.WaitGroup
+
+	stats *stats
+
+	w   *glfw.Window
+	img *image.RGBA
+
+	presenter Presenter
+	context   Context
+
+	// frame pacing fields are only used by the present thread
+	frameDelay  time.Duration
+	lastFrame   time.Time
+	immediate   bool
+	vsync       bool
+	frameEvents bool
+	frames      FrameWriter
+
+	// pixelScaleX and pixelScaleY are the number of pixels per screen
+	// coordinate, they are only used on the main thread.
+	pixelScaleX, pixelScaleY float64
 
-	newSize chan image.Rectangle
-	finish  chan struct{}
+	// windowed is the position and size of the window
+	// in screen coordinates before it became fullscreen.
+	windowed image.Rectangle
 
-	w     *glfw.Window
-	img   *image.RGBA
-	ratio int
+	sidedKeys bool
+
+	// destroyed is set when the GLFW window has been destroyed,
+	// after which it must not be used. It is only used on the main thread.
+	destroyed bool
+
+	// cursor fields are only used on the main thread
+	cursor           *glfw.Cursor
+	cursorMode       CursorMode
+	cursorX, cursorY float64
 }
 
 // Open a new window with all the supplied options.
@@ -48,44 +97,63 @@ type Window struct {
 // The default title is empty and the default size is 640x480.
 func Open(opts ...Option) (*Window, error) {
 	o := newOptions(opts...)
-	w := newWindow()
+	w := newWindow(o.queue)
+	w.sidedKeys = o.sidedKeys
+	w.frameDelay = frameDelay(o.frameRate)
+	w.immediate = o.immediate
+	w.vsync = o.vsync
+	w.frameEvents = o.frameEvents
+	w.frames = o.frames
+
+	w.presenter = o.presenter
+	if w.presenter == nil {
+		w.presenter = NewDrawPixelsPresenter()
+	}
+
+	w.context = contextOf(w.presenter)
 
 	var err error
 
-	mainthread.Call(func() {
-		w.w, err = makeGLFWWindow(&o)
+	call(func() {
+		w.w, err = makeGLFWWindow(&o, w.context)
 	})
 
 	if err != nil {
 		return nil, err
 	}
 
-	mainthread.Call(func() {
-		// HiDPI hack
-		width, _ := w.w.GetFramebufferSize()
+	var bounds image.Rectangle
 
-		w.ratio = width / o.width
-		if w.ratio != 1 {
-			o.width /= w.ratio
-			o.height /= w.ratio
-		}
+	call(func() {
+		w.pixelScaleX, w.pixelScaleY = pixelScale(w.w)
 
-		w.w.Destroy()
-		w.w, err = makeGLFWWindow(&o)
+		width, height := w.w.GetFramebufferSize()
+		bounds = image.Rect(0, 0, width, height)
 	})
 
-	if err != nil {
-		return nil, err
-	}
-
-	w.img = image.NewRGBA(image.Rect(0, 0, o.width*w.ratio, o.height*w.ratio))
+	w.img = image.NewRGBA(bounds)
 
 	go func() {
 		runtime.LockOSThread()
-		w.openGLThread()
+		w.presentThread()
 	}()
 
-	mainthread.CallNonBlock(w.eventThread)
+	if o.updateRate > 0 {
+		w.senders.Add(1)
+
+		go func() {
+			defer w.senders.Done()
+			sendUpdates(o.updateRate, w.in, w.finish)
+		}()
+	}
+
+	w.in <- EventResize{w.img.Bounds()}
+
+	call(w.setCallbacks)
+
+	addWindow(w)
+
+	go w.eventThread()
 
 	return w, nil
 }
@@ -94,9 +162,29 @@ func (w *Window) Send(e Event) {
 	w.in <- e
 }
 
+// Stats returns a snapshot of the performance counters of the window.
+func (w *Window) Stats() Stats {
+	return w.stats.snapshot(time.Now())
+}
+
+// Screenshot returns a copy of the current image of the window,
+// or nil if the window has been closed.
+func (w *Window) Screenshot() *image.RGBA {
+	ch := make(chan *image.RGBA)
+
+	select {
+	case w.snapshot <- ch:
+		return <-ch
+	case <-w.finish:
+		return nil
+	}
+}
+
 // Events returns the events channel of the window.
 func (w *Window) Events() <-chan Event { return w.out }
 
+func (w *Window) eventQueue() QueueConfig { return w.queue }
+
 // Draw to the window using the provided function.
 func (w *Window) Draw(fn func(draw.Image) image.Rectangle) {
 	w.draw <- fn
@@ -107,30 +195,89 @@ func (w *Window) Close() {
 	close(w.draw)
 }
 
+// ClipboardText returns the text contents of the system clipboard.
+func (w *Window) ClipboardText() string {
+	var text string
+
+	w.call(func() {
+		text = w.w.GetClipboardString()
+	})
+
+	return text
+}
+
+// SetClipboardText sets the system clipboard to the text.
+func (w *Window) SetClipboardText(text string) {
+	w.call(func() {
+		w.w.SetClipboardString(text)
+	})
+}
+
+// call calls fn on the main thread, unless the window has been destroyed.
+func (w *Window) call(fn func()) {
+	call(func() {
+		if !w.destroyed {
+			fn()
+		}
+	})
+}
+
+// eventThread waits for the window to finish, then destroys it. The events
+// of all windows are handled by the shared event pump on the main thread.
 func (w *Window) eventThread() {
-	var moX, moY int
+	<-w.finish
+
+	w.senders.Wait()
+
+	call(w.destroy)
+
+	close(w.in)
+}
+
+func (w *Window) destroy() {
+	removeWindow(w)
+
+	w.w.Destroy()
+	w.destroyed = true
+
+	if w.cursor != nil {
+		w.cursor.Destroy()
+	}
+}
+
+func (w *Window) setCallbacks() {
+	// Report the state of caps lock and num lock as modifiers
+	w.w.SetInputMode(glfw.LockKeyMods, glfw.True)
 
 	w.w.SetCursorPosCallback(func(_ *glfw.Window, x, y float64) {
-		moX, moY = int(x), int(y)
-		w.in <- EventMouseMove{image.Point{moX * w.ratio, moY * w.ratio}}
+		prevX, prevY := w.cursorX, w.cursorY
+		w.cursorX, w.cursorY = x, y
+
+		if w.cursorMode == CursorDisabled {
+			w.in <- EventMouseRelative{w.relativePixels(prevX, prevY, x, y)}
+			return
+		}
+
+		w.in <- EventMouseMove{w.pixels(x, y)}
 	})
 
 	w.w.SetMouseButtonCallback(func(_ *glfw.Window, button glfw.MouseButton, action glfw.Action, mod glfw.ModifierKey) {
-		pos := image.Point{moX * w.ratio, moY * w.ratio}
+		pos := w.pixels(w.cursorX, w.cursorY)
+		mods := modifiers(mod)
 
 		switch {
 		case button == glfw.MouseButtonLeft && action == glfw.Press:
-			w.in <- EventMouseLeftDown{pos}
+			w.in <- EventMouseLeftDown{pos, mods}
 		case button == glfw.MouseButtonLeft && action == glfw.Release:
-			w.in <- EventMouseLeftUp{pos}
+			w.in <- EventMouseLeftUp{pos, mods}
 		case button == glfw.MouseButtonMiddle && action == glfw.Press:
-			w.in <- EventMouseMiddleDown{pos}
+			w.in <- EventMouseMiddleDown{pos, mods}
 		case button == glfw.MouseButtonMiddle && action == glfw.Release:
-			w.in <- EventMouseMiddleUp{pos}
+			w.in <- EventMouseMiddleUp{pos, mods}
 		case button == glfw.MouseButtonRight && action == glfw.Press:
-			w.in <- EventMouseRightDown{pos}
+			w.in <- EventMouseRightDown{pos, mods}
 		case button == glfw.MouseButtonRight && action == glfw.Release:
-			w.in <- EventMouseRightUp{pos}
+			w.in <- EventMouseRightUp{pos, mods}
 		}
 	})
 
@@ -138,60 +285,112 @@ func (w *Window) eventThread() {
 		w.in <- EventMouseScroll{image.Point{int(xoff), int(yoff)}}
 	})
 
+	w.w.SetDropCallback(func(_ *glfw.Window, names []string) {
+		w.in <- EventDrop{w.pixels(w.cursorX, w.cursorY), names}
+	})
+
 	w.w.SetCharCallback(func(_ *glfw.Window, r rune) {
 		w.in <- EventKeyboardChar{r}
 	})
 
-	w.w.SetKeyCallback(func(_ *glfw.Window, key glfw.Key, _ int, action glfw.Action, _ glfw.ModifierKey) {
-		k, ok := keyNames[key]
-		if !ok {
-			return
+	w.w.SetKeyCallback(func(_ *glfw.Window, key glfw.Key, scancode int, action glfw.Action, mod glfw.ModifierKey) {
+		k, ok := sidedKeyNames[key]
+		if !ok || !w.sidedKeys {
+			k = keyNames[key]
 		}
 
+		mods := modifiers(mod)
+
 		switch action {
 		case glfw.Press:
-			w.in <- EventKeyboardDown{k}
+			w.in <- EventKeyboardDown{k, mods, scancode}
 		case glfw.Release:
-			w.in <- EventKeyboardUp{k}
+			w.in <- EventKeyboardUp{k, mods, scancode}
 		case glfw.Repeat:
-			w.in <- EventKeyboardRepeat{k}
+			w.in <- EventKeyboardRepeat{k, mods, scancode}
 		}
 	})
 
 	w.w.SetFramebufferSizeCallback(func(_ *glfw.Window, width, height int) {
 		r := image.Rect(0, 0, width, height)
-		w.newSize <- r
+
+		// The framebuffer changes size when the window
+		// is moved to a monitor with a different scale.
+		w.pixelScaleX, w.pixelScaleY = pixelScale(w.w)
+
+		// The draw loop is gone once the window is finishing, and
+		// blocking here would block the event pump for all windows.
+		select {
+		case w.newSize <- r:
+		case <-w.finish:
+			return
+		}
+
 		w.in <- EventResize{r}
 	})
 
+	w.w.SetContentScaleCallback(func(_ *glfw.Window, x, _ float32) {
+		w.pixelScaleX, w.pixelScaleY = pixelScale(w.w)
+
+		w.in <- EventScale{float64(x)}
+	})
+
 	w.w.SetCloseCallback(func(_ *glfw.Window) {
 		w.in <- EventClose{}
 	})
 
-	w.in <- EventResize{w.img.Bounds()}
+	w.w.SetFocusCallback(func(_ *glfw.Window, focused bool) {
+		if focused {
+			w.in <- EventFocusGained{}
+		} else {
+			w.in <- EventFocusLost{}
+		}
+	})
 
-	for {
-		select {
-		case <-w.finish:
-			close(w.in)
-			w.w.Destroy()
-			return
-		default:
-			glfw.WaitEventsTimeout(1.0 / 30)
+	w.w.SetCursorEnterCallback(func(_ *glfw.Window, entered bool) {
+		if entered {
+			w.in <- EventMouseEnter{}
+		} else {
+			w.in <- EventMouseLeave{}
 		}
-	}
+	})
+
+	w.w.SetIconifyCallback(func(_ *glfw.Window, iconified bool) {
+		if iconified {
+			w.in <- EventMinimize{}
+		} else {
+			w.in <- EventRestore{}
+		}
+	})
+
+	w.w.SetMaximizeCallback(func(_ *glfw.Window, maximized bool) {
+		if maximized {
+			w.in <- EventMaximize{}
+		} else {
+			w.in <- EventRestore{}
+		}
+	})
+
+	w.w.SetPosCallback(func(_ *glfw.Window, x, y int) {
+		w.in <- EventMove{image.Point{x, y}}
+	})
 }
 
-func (w *Window) openGLThread() {
-	w.w.MakeContextCurrent()
-	gl.Init()
+func (w *Window) presentThread() {
+	w.presenter.Init(windowSurface{w})
 
-	w.openGLFlush(w.img.Bounds())
+	w.present(w.img.Bounds())
 
-loop:
-	for {
-		var totalR image.Rectangle
+	// The timer is only running while a frame is pending
+	timer := time.NewTimer(time.Hour)
+	timer.Stop()
+
+	var (
+		totalR  image.Rectangle
+		pending <-chan time.Time
+	)
 
+	for {
 		select {
 		case r := <-w.newSize:
 			img := image.NewRGBA(r)
@@ -201,93 +400,256 @@ loop:
 
 		case d, ok := <-w.draw:
 			if !ok {
+				timer.Stop()
+
+				// Present the damage of the last frame, that
+				// would otherwise never be shown or captured
+				if !totalR.Empty() {
+					w.present(totalR)
+				}
+
 				close(w.finish)
 				return
 			}
 			r := d(w.img)
 			totalR = totalR.Union(r)
+			w.stats.draw(time.Now())
+
+		case ch := <-w.snapshot:
+			ch <- copyRGBA(w.img)
+			continue
+
+		case <-pending:
+			pending = nil
+			w.present(totalR)
+			totalR = image.ZR
+			continue
 		}
 
-		for {
-			select {
-			case <-time.After(time.Second / 960):
-				w.openGLFlush(totalR)
-				totalR = image.ZR
-				continue loop
-
-			case r := <-w.newSize:
-				img := image.NewRGBA(r)
-				draw.Draw(img, w.img.Bounds(), w.img, w.img.Bounds().Min, draw.Src)
-				w.img = img
-				totalR = totalR.Union(r)
-
-			case d, ok := <-w.draw:
-				if !ok {
-					close(w.finish)
-					return
-				}
-				r := d(w.img)
-				totalR = totalR.Union(r)
-			}
+		// There is nothing to present
+		if totalR.Empty() {
+			continue
+		}
+
+		switch {
+		case w.immediate:
+			w.present(totalR)
+			totalR = image.ZR
+		case pending == nil:
+			timer.Reset(time.Until(w.lastFrame.Add(w.frameDelay)))
+			pending = timer.C
+		}
+	}
+}
+
+// present flushes the damaged rectangle r, writes the frame if frames
+// are captured, and sends an EventFrame if frame events are enabled.
+// Nothing but the flush happens if r turns out to be empty.
+func (w *Window) present(r image.Rectangle) {
+	start := time.Now()
+
+	if !w.flush(r) {
+		return
+	}
+
+	if w.frames != nil {
+		w.frames.WriteFrame(w.img, start)
+	}
+
+	if w.frameEvents {
+		var delta time.Duration
+
+		if !w.lastFrame.IsZero() {
+			delta = start.Sub(w.lastFrame)
 		}
+
+		w.in <- EventFrame{delta, time.Since(start)}
 	}
+
+	w.lastFrame = start
 }
 
-func (w *Window) openGLFlush(r image.Rectangle) {
+// flush presents the damaged rectangle r, returning false if it was empty.
+func (w *Window) flush(r image.Rectangle) bool {
 	bounds := w.img.Bounds()
 
 	r = r.Intersect(bounds)
 
 	if r.Empty() {
-		return
+		return false
 	}
 
-	tmp := image.NewRGBA(r)
+	start := time.Now()
 
-	draw.Draw(tmp, r, w.img, r.Min, draw.Src)
+	w.presenter.Present(w.img, r)
 
-	gl.DrawBuffer(gl.FRONT)
-	gl.Viewport(
-		int32(bounds.Min.X),
-		int32(bounds.Min.Y),
-		int32(bounds.Dx()),
-		int32(bounds.Dy()),
-	)
-	gl.RasterPos2d(
-		-1+2*float64(r.Min.X)/float64(bounds.Dx()),
-		+1-2*float64(r.Min.Y)/float64(bounds.Dy()),
-	)
-	gl.PixelZoom(1, -1)
-	gl.DrawPixels(
-		int32(r.Dx()),
-		int32(r.Dy()),
-		gl.RGBA,
-		gl.UNSIGNED_BYTE,
-		unsafe.Pointer(&tmp.Pix[0]),
-	)
-	gl.Flush()
+	var uploaded int
+
+	if p, ok := w.presenter.(UploadPresenter); ok {
+		uploaded = p.Uploaded()
+	}
+
+	w.stats.flush(start, time.Since(start), r.Dx()*r.Dy(), bounds.Dx()*bounds.Dy(), uploaded)
+
+	return true
 }
 
-func makeGLFWWindow(o *options) (*glfw.Window, error) {
-	if err := glfw.Init(); err != nil {
+func makeGLFWWindow(o *options, context Context) (*glfw.Window, error) {
+	if err := initGLFW(); err != nil {
 		return nil, err
 	}
 
+	// The hints are kept from any previously created window
+	glfw.DefaultWindowHints()
+
+	switch context {
+	case ContextOpenGLCore:
+		glfw.WindowHint(glfw.ContextVersionMajor, 3)
+		glfw.WindowHint(glfw.ContextVersionMinor, 2)
+		glfw.WindowHint(glfw.OpenGLProfile, glfw.OpenGLCoreProfile)
+		glfw.WindowHint(glfw.OpenGLForwardCompatible, glfw.True)
+	case ContextNone:
+		glfw.WindowHint(glfw.ClientAPI, glfw.NoAPI)
+	}
+
 	glfw.WindowHint(glfw.DoubleBuffer, glfw.True)
+	glfw.WindowHint(glfw.Resizable, glfwBool(o.resizable))
+	glfw.WindowHint(glfw.Decorated, glfwBool(o.decorated))
+	glfw.WindowHint(glfw.Floating, glfwBool(o.floating))
+	glfw.WindowHint(glfw.TransparentFramebuffer, glfwBool(o.transparent))
+
+	// The window is shown after it has been placed
+	glfw.WindowHint(glfw.Visible, glfw.False)
 
-	if o.resizable {
-		glfw.WindowHint(glfw.Resizable, glfw.True)
-	} else {
-		glfw.WindowHint(glfw.Resizable, glfw.False)
+	win, err := glfw.CreateWindow(o.width, o.height, o.title, nil, nil)
+	if err != nil {
+		return nil, err
 	}
 
-	if o.decorated {
-		glfw.WindowHint(glfw.Decorated, glfw.True)
-	} else {
-		glfw.WindowHint(glfw.Decorated, glfw.False)
+	// The sizes in the options are in pixels, while GLFW uses screen
+	// coordinates, that are scaled on some platforms with HiDPI monitors.
+	sx, sy := pixelScale(win)
+
+	if sx != 1 || sy != 1 {
+		win.SetSize(screenSize(o.width, sx), screenSize(o.height, sy))
 	}
 
-	return glfw.CreateWindow(o.width, o.height, o.title, nil, nil)
+	if o.minWidth > 0 || o.minHeight > 0 || o.maxWidth > 0 || o.maxHeight > 0 {
+		win.SetSizeLimits(
+			glfwLimit(screenSize(o.minWidth, sx)),
+			glfwLimit(screenSize(o.minHeight, sy)),
+			glfwLimit(screenSize(o.maxWidth, sx)),
+			glfwLimit(screenSize(o.maxHeight, sy)),
+		)
+	}
+
+	if o.aspectNumer > 0 && o.aspectDenom > 0 {
+		win.SetAspectRatio(o.aspectNumer, o.aspectDenom)
+	}
+
+	if o.icon != nil {
+		win.SetIcon([]image.Image{o.icon})
+	}
+
+	switch {
+	case o.centered:
+		if m := glfw.GetPrimaryMonitor(); m != nil {
+			x, y, width, height := m.GetWorkarea()
+			winWidth, winHeight := win.GetSize()
+
+			win.SetPos(x+(width-winWidth)/2, y+(height-winHeight)/2)
+		}
+	case o.positioned:
+		win.SetPos(o.x, o.y)
+	}
+
+	if o.visible {
+		win.Show()
+	}
+
+	return win, nil
+}
+
+// frameDelay returns the minimum delay between frames for the given frame
+// rate, a frame rate of zero only batches draws that happen in close succession.
+func frameDelay(rate int) time.Duration {
+	if rate <= 0 {
+		return time.Second / 960
+	}
+
+	return time.Second / time.Duration(rate)
+}
+
+// pixels converts from screen coordinates to pixels.
+func (w *Window) pixels(x, y float64) image.Point {
+	return image.Point{
+		int(math.Floor(x * w.pixelScaleX)),
+		int(math.Floor(y * w.pixelScaleY)),
+	}
+}
+
+// relativePixels converts a movement from prevX, prevY to x, y in screen
+// coordinates to pixels. The difference of the positions in pixels is used,
+// instead of converting the difference, in order for rounding to not make
+// the sum of many small movements drift.
+func (w *Window) relativePixels(prevX, prevY, x, y float64) image.Point {
+	return w.pixels(x, y).Sub(w.pixels(prevX, prevY))
+}
+
+// pixelScale returns the number of pixels per screen coordinate of win.
+func pixelScale(win *glfw.Window) (float64, float64) {
+	fbWidth, fbHeight := win.GetFramebufferSize()
+	width, height := win.GetSize()
+
+	if fbWidth <= 0 || fbHeight <= 0 || width <= 0 || height <= 0 {
+		return 1, 1
+	}
+
+	return float64(fbWidth) / float64(width), float64(fbHeight) / float64(height)
+}
+
+// screenSize converts a size in pixels to screen coordinates.
+func screenSize(size int, scale float64) int {
+	return int(math.Round(float64(size) / scale))
+}
+
+func glfwBool(b bool) int {
+	if b {
+		return glfw.True
+	}
+
+	return glfw.False
+}
+
+// glfwLimit returns glfw.DontCare for sizes that are not limited.
+func glfwLimit(size int) int {
+	if size <= 0 {
+		return glfw.DontCare
+	}
+
+	return size
+}
+
+func modifiers(mod glfw.ModifierKey) Modifiers {
+	var m Modifiers
+
+	for _, mm := range []struct {
+		mod glfw.ModifierKey
+		m   Modifiers
+	}{
+		{glfw.ModShift, ModShift},
+		{glfw.ModControl, ModCtrl},
+		{glfw.ModAlt, ModAlt},
+		{glfw.ModSuper, ModSuper},
+		{glfw.ModCapsLock, ModCapsLock},
+		{glfw.ModNumLock, ModNumLock},
+	} {
+		if mod&mm.mod != 0 {
+			m |= mm.m
+		}
+	}
+
+	return m
 }
 
 var keyNames = map[glfw.Key]string{
@@ -311,4 +673,116 @@ var keyNames = map[glfw.Key]string{
 	glfw.KeyRightControl: "ctrl",
 	glfw.KeyLeftAlt:      "alt",
 	glfw.KeyRightAlt:     "alt",
+	glfw.KeyLeftSuper:    "super",
+	glfw.KeyRightSuper:   "super",
+	glfw.KeyInsert:       "insert",
+	glfw.KeyCapsLock:     "capslock",
+	glfw.KeyScrollLock:   "scrolllock",
+	glfw.KeyNumLock:      "numlock",
+	glfw.KeyPrintScreen:  "printscreen",
+	glfw.KeyPause:        "pause",
+	glfw.KeyMenu:         "menu",
+	glfw.KeyApostrophe:   "apostrophe",
+	glfw.KeyComma:        "comma",
+	glfw.KeyMinus:        "minus",
+	glfw.KeyPeriod:       "period",
+	glfw.KeySlash:        "slash",
+	glfw.KeySemicolon:    "semicolon",
+	glfw.KeyEqual:        "equal",
+	glfw.KeyLeftBracket:  "leftbracket",
+	glfw.KeyBackslash:    "backslash",
+	glfw.KeyRightBracket: "rightbracket",
+	glfw.KeyGraveAccent:  "graveaccent",
+	glfw.KeyWorld1:       "world1",
+	glfw.KeyWorld2:       "world2",
+	glfw.Key0:            "0",
+	glfw.Key1:            "1",
+	glfw.Key2:            "2",
+	glfw.Key3:            "3",
+	glfw.Key4:            "4",
+	glfw.Key5:            "5",
+	glfw.Key6:            "6",
+	glfw.Key7:            "7",
+	glfw.Key8:            "8",
+	glfw.Key9:            "9",
+	glfw.KeyA:            "a",
+	glfw.KeyB:            "b",
+	glfw.KeyC:            "c",
+	glfw.KeyD:            "d",
+	glfw.KeyE:            "e",
+	glfw.KeyF:            "f",
+	glfw.KeyG:            "g",
+	glfw.KeyH:            "h",
+	glfw.KeyI:            "i",
+	glfw.KeyJ:            "j",
+	glfw.KeyK:            "k",
+	glfw.KeyL:            "l",
+	glfw.KeyM:            "m",
+	glfw.KeyN:            "n",
+	glfw.KeyO:            "o",
+	glfw.KeyP:            "p",
+	glfw.KeyQ:            "q",
+	glfw.KeyR:            "r",
+	glfw.KeyS:            "s",
+	glfw.KeyT:            "t",
+	glfw.KeyU:            "u",
+	glfw.KeyV:            "v",
+	glfw.KeyW:            "w",
+	glfw.KeyX:            "x",
+	glfw.KeyY:            "y",
+	glfw.KeyZ:            "z",
+	glfw.KeyF1:           "f1",
+	glfw.KeyF2:           "f2",
+	glfw.KeyF3:           "f3",
+	glfw.KeyF4:           "f4",
+	glfw.KeyF5:           "f5",
+	glfw.KeyF6:           "f6",
+	glfw.KeyF7:           "f7",
+	glfw.KeyF8:           "f8",
+	glfw.KeyF9:           "f9",
+	glfw.KeyF10:          "f10",
+	glfw.KeyF11:          "f11",
+	glfw.KeyF12:          "f12",
+	glfw.KeyF13:          "f13",
+	glfw.KeyF14:          "f14",
+	glfw.KeyF15:          "f15",
+	glfw.KeyF16:          "f16",
+	glfw.KeyF17:          "f17",
+	glfw.KeyF18:          "f18",
+	glfw.KeyF19:          "f19",
+	glfw.KeyF20:          "f20",
+	glfw.KeyF21:          "f21",
+	glfw.KeyF22:          "f22",
+	glfw.KeyF23:          "f23",
+	glfw.KeyF24:          "f24",
+	glfw.KeyF25:          "f25",
+	glfw.KeyKP0:          "kp0",
+	glfw.KeyKP1:          "kp1",
+	glfw.KeyKP2:          "kp2",
+	glfw.KeyKP3:          "kp3",
+	glfw.KeyKP4:          "kp4",
+	glfw.KeyKP5:          "kp5",
+	glfw.KeyKP6:          "kp6",
+	glfw.KeyKP7:          "kp7",
+	glfw.KeyKP8:          "kp8",
+	glfw.KeyKP9:          "kp9",
+	glfw.KeyKPDecimal:    "kpdecimal",
+	glfw.KeyKPDivide:     "kpdivide",
+	glfw.KeyKPMultiply:   "kpmultiply",
+	glfw.KeyKPSubtract:   "kpsubtract",
+	glfw.KeyKPAdd:        "kpadd",
+	glfw.KeyKPEnter:      "kpenter",
+	glfw.KeyKPEqual:      "kpequal",
+}
+
+// sidedKeyNames are used instead of keyNames when the SidedModifierKeys option is set.
+var sidedKeyNames = map[glfw.Key]string{
+	glfw.KeyLeftShift:    "leftshift",
+	glfw.KeyRightShift:   "rightshift",
+	glfw.KeyLeftControl:  "leftctrl",
+	glfw.KeyRightControl: "rightctrl",
+	glfw.KeyLeftAlt:      "leftalt",
+	glfw.KeyRightAlt:     "rightalt",
+	glfw.KeyLeftSuper:    "leftsuper",
+	glfw.KeyRightSuper:   "rightsuper",
 }
@@ -1,9 +1,270 @@
 package gui
 
-import "testing"
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"testing"
+	"time"
+
+	"github.com/go-gl/glfw/v3.3/glfw"
+)
 
 func TestNewWindow(t *testing.T) {
-	if got := newWindow(); got == nil {
+	if got := newWindow(QueueConfig{}); got == nil {
 		t.Fatalf("expected *Window, got nil")
 	}
 }
+
+func TestKeyNames(t *testing.T) {
+	for key, name := range sidedKeyNames {
+		if _, ok := keyNames[key]; !ok {
+			t.Fatalf("sided key %q is missing in keyNames", name)
+		}
+	}
+
+	for key, want := range map[glfw.Key]string{
+		glfw.KeyA:        "a",
+		glfw.Key0:        "0",
+		glfw.KeyF12:      "f12",
+		glfw.KeyKPEnter:  "kpenter",
+		glfw.KeySlash:    "slash",
+		glfw.KeyLeftAlt:  "alt",
+		glfw.KeyEscape:   "escape",
+		glfw.KeyPageDown: "pagedown",
+	} {
+		if got := keyNames[key]; got != want {
+			t.Fatalf("keyNames[%d] = %q, want %q", key, got, want)
+		}
+	}
+}
+
+func TestModifiers(t *testing.T) {
+	mod := glfw.ModControl | glfw.ModShift | glfw.ModCapsLock
+
+	if got, want := modifiers(mod), ModCtrl|ModShift|ModCapsLock; got != want {
+		t.Fatalf("modifiers(%d) = %v, want %v", mod, got, want)
+	}
+}
+
+func TestWindowPixels(t *testing.T) {
+	w := &Window{pixelScaleX: 1.5, pixelScaleY: 1.25}
+
+	if got, want := w.pixels(10.5, 4), image.Pt(15, 5); got != want {
+		t.Fatalf("w.pixels(10.5, 4) = %v, want %v", got, want)
+	}
+
+	if got, want := w.pixels(-1, -1), image.Pt(-2, -2); got != want {
+		t.Fatalf("w.pixels(-1, -1) = %v, want %v", got, want)
+	}
+}
+
+func TestWindowRelativePixels(t *testing.T) {
+	w := &Window{pixelScaleX: 1.25, pixelScaleY: 1.25}
+
+	if got, want := w.relativePixels(0, 0, 1, 1), image.Pt(1, 1); got != want {
+		t.Fatalf("w.relativePixels(0, 0, 1, 1) = %v, want %v", got, want)
+	}
+
+	if got, want := w.relativePixels(1, 1, 0, 0), image.Pt(-1, -1); got != want {
+		t.Fatalf("w.relativePixels(1, 1, 0, 0) = %v, want %v", got, want)
+	}
+
+	var sum image.Point
+
+	// Moving back and forth should not drift
+	for i := 0; i < 4; i++ {
+		sum = sum.Add(w.relativePixels(0, 0, 1, 1))
+		sum = sum.Add(w.relativePixels(1, 1, 0, 0))
+	}
+
+	if got, want := sum, image.ZP; got != want {
+		t.Fatalf("sum = %v, want %v", got, want)
+	}
+
+	sum = image.ZP
+
+	// Moving in small steps should add up to the total movement
+	for i := 0; i < 8; i++ {
+		x := -0.5 * float64(i)
+		sum = sum.Add(w.relativePixels(x, x, x-0.5, x-0.5))
+	}
+
+	if got, want := sum, image.Pt(-5, -5); got != want {
+		t.Fatalf("sum = %v, want %v", got, want)
+	}
+}
+
+func TestScreenSize(t *testing.T) {
+	for _, tt := range []struct {
+		size  int
+		scale float64
+		want  int
+	}{
+		{640, 1, 640},
+		{640, 2, 320},
+		{640, 1.25, 512},
+		{641, 1.5, 427},
+		{0, 1.5, 0},
+	} {
+		if got := screenSize(tt.size, tt.scale); got != tt.want {
+			t.Fatalf("screenSize(%d, %v) = %d, want %d", tt.size, tt.scale, got, tt.want)
+		}
+	}
+}
+
+func TestWindowPresentThread(t *testing.T) {
+	t.Run("Immediate", func(t *testing.T) {
+		p := &testPresenter{}
+
+		w := newWindow(QueueConfig{})
+		w.img = image.NewRGBA(image.Rect(0, 0, 10, 10))
+		w.presenter = p
+		w.immediate = true
+		w.frameEvents = true
+
+		go w.presentThread()
+
+		// The initial present of the whole image
+		<-w.Events()
+
+		for i := 0; i < 3; i++ {
+			i := i
+
+			w.Draw(func(dst draw.Image) image.Rectangle {
+				return image.Rect(i, i, i+1, i+1)
+			})
+
+			if _, ok := (<-w.Events()).(EventFrame); !ok {
+				t.Fatalf("expected EventFrame")
+			}
+		}
+
+		w.Close()
+		<-w.finish
+
+		if got, want := len(p.damage), 4; got != want {
+			t.Fatalf("len(p.damage) = %d, want %d", got, want)
+		}
+
+		if got, want := p.damage[3], image.Rect(2, 2, 3, 3); got != want {
+			t.Fatalf("p.damage[3] = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("EmptyDamage", func(t *testing.T) {
+		p := &testPresenter{}
+
+		w := newWindow(QueueConfig{})
+		w.img = image.NewRGBA(image.Rect(0, 0, 10, 10))
+		w.presenter = p
+		w.immediate = true
+		w.frameEvents = true
+
+		go w.presentThread()
+
+		<-w.Events()
+
+		w.Draw(func(dst draw.Image) image.Rectangle {
+			return image.ZR
+		})
+
+		w.Draw(func(dst draw.Image) image.Rectangle {
+			return image.Rect(20, 20, 30, 30)
+		})
+
+		w.Close()
+		<-w.finish
+
+		select {
+		case e := <-w.Events():
+			t.Fatalf("unexpected event %v", e)
+		case <-time.After(10 * time.Millisecond):
+		}
+
+		if got, want := len(p.damage), 1; got != want {
+			t.Fatalf("len(p.damage) = %d, want %d", got, want)
+		}
+	})
+
+	t.Run("FrameRate", func(t *testing.T) {
+		p := &testPresenter{}
+
+		w := newWindow(QueueConfig{})
+		w.img = image.NewRGBA(image.Rect(0, 0, 10, 10))
+		w.presenter = p
+		w.frameDelay = time.Hour
+		w.frameEvents = true
+
+		go w.presentThread()
+
+		<-w.Events()
+
+		for i := 0; i < 3; i++ {
+			i := i
+
+			w.Draw(func(dst draw.Image) image.Rectangle {
+				return image.Rect(i, i, i+1, i+1)
+			})
+		}
+
+		w.Close()
+		<-w.finish
+
+		// The pending frame is presented when the window is closed
+		if got, want := len(p.damage), 2; got != want {
+			t.Fatalf("len(p.damage) = %d, want %d", got, want)
+		}
+
+		if got, want := p.damage[1], image.Rect(0, 0, 3, 3); got != want {
+			t.Fatalf("p.damage[1] = %v, want %v", got, want)
+		}
+	})
+}
+
+func TestFrameDelay(t *testing.T) {
+	if got, want := frameDelay(0), time.Second/960; got != want {
+		t.Fatalf("frameDelay(0) = %v, want %v", got, want)
+	}
+
+	if got, want := frameDelay(50), 20*time.Millisecond; got != want {
+		t.Fatalf("frameDelay(50) = %v, want %v", got, want)
+	}
+}
+
+func TestWindowScreenshot(t *testing.T) {
+	fw := &recordingFrameWriter{}
+
+	w := newWindow(QueueConfig{})
+	w.img = image.NewRGBA(image.Rect(0, 0, 10, 10))
+	w.presenter = NewNopPresenter()
+	w.immediate = true
+	w.frames = fw
+
+	go w.presentThread()
+
+	red := color.RGBA{255, 0, 0, 255}
+
+	w.Draw(func(dst draw.Image) image.Rectangle {
+		dst.Set(1, 1, red)
+
+		return image.Rect(1, 1, 2, 2)
+	})
+
+	img := w.Screenshot()
+
+	if got, want := img.At(1, 1), red; got != want {
+		t.Fatalf("img.At(1, 1) = %v, want %v", got, want)
+	}
+
+	w.Close()
+	<-w.finish
+
+	if got := w.Screenshot(); got != nil {
+		t.Fatalf("w.Screenshot() = %v, want nil", got)
+	}
+
+	if got, want := len(fw.frames), 2; got != want {
+		t.Fatalf("len(fw.frames) = %d, want %d", got, want)
+	}
+}